@@ -0,0 +1,68 @@
+package benchmarks
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/metronlab/bow"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// BenchmarkWriteParquet compares the columnar parquet writer of Bow
+// with a row-based writer marshaling each row to JSON, as Bow.WriteParquet used to do.
+func BenchmarkWriteParquet(b *testing.B) {
+	for rows := 10; rows <= 100000; rows *= 10 {
+		data, err := bow.NewBowFromParquet(fmt.Sprintf("bow1-%d-rows.parquet", rows), false)
+		require.NoError(b, err)
+
+		b.Run(fmt.Sprintf("%d_rows/columnar", rows), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				require.NoError(b, data.WriteParquetTo(io.Discard, bow.ParquetWriteOptions{}, false))
+			}
+		})
+
+		b.Run(fmt.Sprintf("%d_rows/row_json", rows), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				require.NoError(b, writeParquetRowJSON(data, io.Discard))
+			}
+		})
+	}
+}
+
+func writeParquetRowJSON(b bow.Bow, w io.Writer) error {
+	parquetTypes := map[bow.Type]string{
+		bow.Int64:   "INT64",
+		bow.Float64: "DOUBLE",
+		bow.Boolean: "BOOLEAN",
+		bow.String:  "BYTE_ARRAY, convertedtype=UTF8",
+	}
+
+	var fields []string
+	for colIndex := 0; colIndex < b.NumCols(); colIndex++ {
+		fields = append(fields, fmt.Sprintf(`{"Tag": "name=%s, type=%s, repetitiontype=OPTIONAL"}`,
+			b.ColumnName(colIndex), parquetTypes[b.ColumnType(colIndex)]))
+	}
+	schema := fmt.Sprintf(`{"Tag": "name=schema, repetitiontype=REQUIRED", "Fields": [%s]}`,
+		strings.Join(fields, ", "))
+
+	parquetWriter, err := writer.NewJSONWriterFromWriter(schema, w, 4)
+	if err != nil {
+		return err
+	}
+
+	for row := range b.GetRowsChan() {
+		rowJSON, err := json.Marshal(row)
+		if err != nil {
+			return err
+		}
+		if err = parquetWriter.Write(string(rowJSON)); err != nil {
+			return err
+		}
+	}
+
+	return parquetWriter.WriteStop()
+}
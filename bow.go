@@ -3,6 +3,7 @@ package bow
 import (
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
 	"time"
 
@@ -60,22 +61,32 @@ type Bow interface {
 
 	Filter(fns ...RowCmp) Bow
 	MakeFilterValues(colIndex int, values ...interface{}) RowCmp
+	FilterWhere(pred Predicate) (Bow, error)
 
 	AddCols(newCols ...Series) (Bow, error)
+	NewSeriesFromExpr(name string, expr Expr) (Series, error)
 	RenameCol(colIndex int, newName string) (Bow, error)
 	Apply(colIndex int, returnType Type, fn func(interface{}) interface{}) (Bow, error)
 	Convert(colIndex int, t Type) (Bow, error)
 
 	InnerJoin(other Bow) Bow
 	OuterJoin(other Bow) Bow
+	Join(other Bow, options JoinOptions) (Bow, error)
+	AsOfJoin(other Bow, options AsOfJoinOptions) (Bow, error)
 
 	Diff(colIndices ...int) (Bow, error)
+	SubTimestamps(leftColIndex, rightColIndex int, newColName string) (Bow, error)
+	AddDuration(colIndex, durationColIndex int) (Bow, error)
+	AddDate(colIndex int, years, months, days int) (Bow, error)
 
 	NewSlice(i, j int) Bow
 	Select(colIndices ...int) (Bow, error)
 	NewEmptySlice() Bow
 	DropNils(colIndices ...int) (Bow, error)
 	SortByCol(colIndex int) (Bow, error)
+	SortBy(keys ...SortKey) (Bow, error)
+	Explode(colIndex int) (Bow, error)
+	Flatten(colIndex int) (Bow, error)
 
 	FillPrevious(colIndices ...int) (Bow, error)
 	FillNext(colIndices ...int) (Bow, error)
@@ -87,9 +98,18 @@ type Bow interface {
 	IsColSorted(colIndex int) bool
 
 	MarshalJSON() (buf []byte, err error)
+	MarshalJSONWithOptions(options JSONOptions) (buf []byte, err error)
 	UnmarshalJSON(data []byte) error
+	UnmarshalJSONWithOptions(data []byte, options JSONOptions) error
 	NewValuesFromJSON(jsonB JSONBow) error
+	NewValuesFromJSONWithOptions(jsonB JSONBow, options JSONOptions) error
+	NewValuesFromJSONColBow(jsonB JSONColBow, options JSONOptions) error
 	WriteParquet(path string, verbose bool) error
+	WriteParquetTo(w io.Writer, options ParquetWriteOptions, verbose bool) error
+	WriteParquetDataset(dir string, partitionColNames []string, options ParquetWriteOptions, verbose bool) error
+	WriteCSV(w io.Writer, options CSVOptions) error
+	WriteNDJSON(w io.Writer, options JSONOptions) error
+	WriteIPC(w io.Writer, format IPCFormat) error
 	GetParquetMetaColTimeUnit(colIndex int) (time.Duration, error)
 }
 
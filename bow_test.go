@@ -2,8 +2,14 @@ package bow
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/decimal128"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -49,6 +55,54 @@ func TestNewBowFromColBasedInterface(t *testing.T) {
 	})
 }
 
+func TestNewBowRescalesDecimals(t *testing.T) {
+	newDecimalSeries := func(precision, scale int32, unscaled ...int64) Series {
+		builder := array.NewDecimal128Builder(memory.NewCheckedAllocator(memory.NewGoAllocator()),
+			&arrow.Decimal128Type{Precision: precision, Scale: scale})
+		defer builder.Release()
+		for _, v := range unscaled {
+			builder.Append(decimal128.FromI64(v))
+		}
+		builder.AppendNull()
+		return Series{Name: "decimal", Array: builder.NewArray()}
+	}
+
+	for _, testCase := range []struct {
+		name     string
+		series   Series
+		expected []interface{}
+	}{
+		{name: "lower scale", series: newDecimalSeries(10, 2, 12345, -1),
+			expected: []interface{}{"123.45", "-0.01", nil}},
+		{name: "greater scale", series: newDecimalSeries(38, 12, 1234567890125, -5),
+			expected: []interface{}{"1.234567890", "0", nil}},
+		{name: "greater scale rounded half away from zero", series: newDecimalSeries(38, 10, 5, -15),
+			expected: []interface{}{"0.000000001", "-0.000000002", nil}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			b, err := NewBow(testCase.series)
+			require.NoError(t, err)
+			assert.Equal(t, Decimal, b.ColumnType(0))
+
+			expected, err := NewBowFromColBasedInterfaces([]string{"decimal"}, []Type{Decimal},
+				[][]interface{}{testCase.expected})
+			require.NoError(t, err)
+			ExpectEqual(t, expected, b)
+		})
+	}
+
+	t.Run("value exceeding the precision", func(t *testing.T) {
+		builder := array.NewDecimal128Builder(memory.NewCheckedAllocator(memory.NewGoAllocator()),
+			&arrow.Decimal128Type{Precision: 38, Scale: 0})
+		defer builder.Release()
+		unscaled, _ := new(big.Int).SetString("100000000000000000000000000000", 10)
+		builder.Append(decimal128.FromBigInt(unscaled))
+
+		_, err := NewBow(Series{Name: "decimal", Array: builder.NewArray()})
+		assert.Error(t, err)
+	})
+}
+
 func TestBow_NewSlice(t *testing.T) {
 	origin, err := NewBowWithMetadata(NewMetadata([]string{"k"}, []string{"v"}),
 		NewSeries("time", Int64, []int64{1, 2, 3}, nil),
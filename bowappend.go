@@ -89,8 +89,37 @@ func AppendBows(bows ...Bow) (Bow, error) {
 				builder.AppendValues(v, valid)
 			}
 			newArray = builder.NewArray()
+		case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+			builder := array.NewTimestampBuilder(mem, refBow.Schema().Field(colIndex).Type.(*arrow.TimestampType))
+			builder.Resize(numRows)
+			for _, b := range bows {
+				if colType := b.ColumnType(colIndex); colType != refType {
+					return nil, fmt.Errorf("incompatible types '%s' and '%s'", refType, colType)
+				}
+				data := b.(*bow).Column(colIndex).Data()
+				arr := array.NewTimestampData(data)
+				v := timestampValues(arr)
+				valid := getValiditySlice(arr)
+				builder.AppendValues(v, valid)
+			}
+			newArray = builder.NewArray()
 		default:
-			return nil, fmt.Errorf("unsupported type '%s'", refType)
+			if !refType.IsSupported() {
+				return nil, fmt.Errorf("unsupported type '%s'", refType)
+			}
+			buf := NewBuffer(numRows, refType)
+			var offset int
+			for _, b := range bows {
+				if colType := b.ColumnType(colIndex); colType != refType {
+					return nil, fmt.Errorf("incompatible types '%s' and '%s'", refType, colType)
+				}
+				colBuf := b.NewBufferFromCol(colIndex)
+				for rowIndex := 0; rowIndex < b.NumRows(); rowIndex++ {
+					buf.SetOrDropStrict(offset+rowIndex, colBuf.GetValue(rowIndex))
+				}
+				offset += b.NumRows()
+			}
+			newArray = NewSeriesFromBuffer("", buf).Array
 		}
 
 		series[colIndex] = Series{
@@ -54,6 +54,37 @@ func TestAppendBows(t *testing.T) {
 			"want:\n%v\nhave:\n%v", b, appended))
 	})
 
+	t.Run("narrower numeric types", func(t *testing.T) {
+		b1, _ := NewBowFromColBasedInterfaces(
+			[]string{"i", "u", "f"},
+			[]Type{Int32, Uint64, Float32},
+			[][]interface{}{
+				{1, nil},
+				{uint64(18446744073709551615), 2},
+				{1.5, 2.5},
+			})
+		b2, _ := NewBowFromColBasedInterfaces(
+			[]string{"i", "u", "f"},
+			[]Type{Int32, Uint64, Float32},
+			[][]interface{}{
+				{3},
+				{nil},
+				{3.5},
+			})
+		expected, _ := NewBowFromColBasedInterfaces(
+			[]string{"i", "u", "f"},
+			[]Type{Int32, Uint64, Float32},
+			[][]interface{}{
+				{1, nil, 3},
+				{uint64(18446744073709551615), 2, nil},
+				{1.5, 2.5, 3.5},
+			})
+		appended, err := AppendBows(b1, b2.NewSlice(0, 1))
+		assert.NoError(t, err)
+		assert.True(t, appended.Equal(expected), fmt.Sprintf(
+			"want:\n%v\nhave:\n%v", expected, appended))
+	})
+
 	t.Run("schema mismatch", func(t *testing.T) {
 		b1, _ := NewBowFromColBasedInterfaces(
 			[]string{"i", "s"},
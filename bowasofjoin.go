@@ -0,0 +1,249 @@
+package bow
+
+import (
+	"fmt"
+	"sort"
+)
+
+// AsOfDirection is the direction in which Bow.AsOfJoin looks for the right row matching a left row.
+type AsOfDirection int
+
+const (
+	// AsOfBackward matches the last right row whose value is lower than or equal to the left value.
+	AsOfBackward AsOfDirection = iota
+	// AsOfForward matches the first right row whose value is greater than or equal to the left value.
+	AsOfForward
+	// AsOfNearest matches the right row whose value is the closest to the left value, the backward one on ties.
+	AsOfNearest
+)
+
+func (d AsOfDirection) String() string {
+	switch d {
+	case AsOfBackward:
+		return "backward"
+	case AsOfForward:
+		return "forward"
+	case AsOfNearest:
+		return "nearest"
+	default:
+		return fmt.Sprintf("AsOfDirection(%d)", int(d))
+	}
+}
+
+// AsOfJoinOptions are options to join two Bows with Bow.AsOfJoin:
+// - LeftOn: name of the left column to match on, of type Int64 or any timestamp Type, sorted in ascending order
+// - RightOn: name of the right column to match on, of the same Type and also sorted, defaults to LeftOn
+// - Direction: direction in which the matching right row is looked for, defaults to AsOfBackward
+// - Tolerance: maximum distance between the matched values, no limit if nil, 0 only matching equal values
+// - LeftBy: names of the left key columns whose values must be equal to the right ones to match
+// - RightBy: names of the right key columns, matched in order with LeftBy, defaults to LeftBy
+// - LeftSuffix: suffix appended to the names of the left non-key columns also found in the right non-key columns
+// - RightSuffix: suffix appended to the names of the right non-key columns also found in the left columns,
+// defaults to "_right" if LeftSuffix is also empty
+type AsOfJoinOptions struct {
+	LeftOn      string
+	RightOn     string
+	Direction   AsOfDirection
+	Tolerance   *int64
+	LeftBy      []string
+	RightBy     []string
+	LeftSuffix  string
+	RightSuffix string
+}
+
+func (o *AsOfJoinOptions) validate() error {
+	if o.LeftOn == "" {
+		return fmt.Errorf("no column to match on")
+	}
+	if o.RightOn == "" {
+		o.RightOn = o.LeftOn
+	}
+	if o.Direction < AsOfBackward || o.Direction > AsOfNearest {
+		return fmt.Errorf("unknown direction '%s'", o.Direction)
+	}
+	if o.Tolerance != nil && *o.Tolerance < 0 {
+		return fmt.Errorf("negative tolerance %d", *o.Tolerance)
+	}
+	if o.RightBy == nil {
+		o.RightBy = o.LeftBy
+	}
+	if len(o.RightBy) != len(o.LeftBy) {
+		return fmt.Errorf("found %d right key columns for %d left key columns", len(o.RightBy), len(o.LeftBy))
+	}
+	if o.LeftSuffix == "" && o.RightSuffix == "" {
+		o.RightSuffix = "_right"
+	}
+	return nil
+}
+
+// AsOfJoin joins each row of the Bow with at most one row of `other`, the one whose value on the column RightOn
+// is the nearest to the left value on the column LeftOn in the Direction of `options`,
+// among the right rows having the same values on the key columns LeftBy and RightBy.
+// All the left rows are kept in their order, the right columns being nil for the left rows without match.
+// The result holds the left columns followed by the right columns except RightOn and RightBy.
+// Nil values on LeftOn and RightOn never match, nil key values match each other and NaN key values match no value,
+// as with Bow.Join.
+// The Metadata of the two Bows are also joined by appending keys and values.
+func (b *bow) AsOfJoin(other Bow, options AsOfJoinOptions) (Bow, error) {
+	left := b
+	right, ok := other.(*bow)
+	if !ok {
+		return nil, fmt.Errorf("non bow object passed as argument")
+	}
+
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+
+	leftOnIndex, err := left.ColumnIndex(options.LeftOn)
+	if err != nil {
+		return nil, fmt.Errorf("left column: %w", err)
+	}
+	rightOnIndex, err := right.ColumnIndex(options.RightOn)
+	if err != nil {
+		return nil, fmt.Errorf("right column: %w", err)
+	}
+	if typ := left.ColumnType(leftOnIndex); typ != Int64 && !typ.IsTimestamp() {
+		return nil, fmt.Errorf("left column '%s': unsupported type '%s'", options.LeftOn, typ)
+	}
+	if left.ColumnType(leftOnIndex) != right.ColumnType(rightOnIndex) {
+		return nil, fmt.Errorf("left column '%s' of type '%s' and right column '%s' of type '%s' are incompatible",
+			options.LeftOn, left.ColumnType(leftOnIndex), options.RightOn, right.ColumnType(rightOnIndex))
+	}
+
+	byCols := make([]joinCol, len(options.LeftBy))
+	for i := range byCols {
+		leftColIndex, err := left.ColumnIndex(options.LeftBy[i])
+		if err != nil {
+			return nil, fmt.Errorf("left key column: %w", err)
+		}
+		rightColIndex, err := right.ColumnIndex(options.RightBy[i])
+		if err != nil {
+			return nil, fmt.Errorf("right key column: %w", err)
+		}
+		if left.ColumnType(leftColIndex) != right.ColumnType(rightColIndex) {
+			return nil, fmt.Errorf("left key column '%s' of type '%s' and right key column '%s' of type '%s' are incompatible",
+				options.LeftBy[i], left.ColumnType(leftColIndex), options.RightBy[i], right.ColumnType(rightColIndex))
+		}
+		byCols[i] = joinCol{leftColIndex: leftColIndex, rightColIndex: rightColIndex}
+	}
+
+	leftValues, err := left.getAsOfValues(leftOnIndex)
+	if err != nil {
+		return nil, fmt.Errorf("left column '%s': %w", options.LeftOn, err)
+	}
+	rightValues, err := right.getAsOfValues(rightOnIndex)
+	if err != nil {
+		return nil, fmt.Errorf("right column '%s': %w", options.RightOn, err)
+	}
+
+	leftBufs := make([]Buffer, len(byCols))
+	rightBufs := make([]Buffer, len(byCols))
+	for i, col := range byCols {
+		leftBufs[i] = left.NewBufferFromCol(col.leftColIndex)
+		rightBufs[i] = right.NewBufferFromCol(col.rightColIndex)
+	}
+
+	// Right rows with a value are gathered by key, in ascending order of value
+	rightRowsByKey := make(map[string][]int)
+	var key []byte
+	for rightRow := right.GetNextRowIndex(rightOnIndex, 0); rightRow != -1; rightRow = right.GetNextRowIndex(rightOnIndex, rightRow+1) {
+		var ok bool
+		if key, ok = appendJoinKey(key[:0], rightBufs, rightRow); ok {
+			rightRowsByKey[string(key)] = append(rightRowsByKey[string(key)], rightRow)
+		}
+	}
+
+	joinedRows := CommonRows{
+		l: make([]int, left.NumRows()),
+		r: make([]int, left.NumRows()),
+	}
+	for leftRow := range joinedRows.l {
+		joinedRows.l[leftRow] = leftRow
+		joinedRows.r[leftRow] = -1
+		if left.Column(leftOnIndex).IsNull(leftRow) {
+			continue
+		}
+
+		var ok bool
+		if key, ok = appendJoinKey(key[:0], leftBufs, leftRow); !ok {
+			continue
+		}
+		joinedRows.r[leftRow] = findAsOfRow(
+			leftValues[leftRow], rightValues, rightRowsByKey[string(key)], options)
+	}
+
+	keyCols := append([]joinCol{{leftColIndex: leftOnIndex, rightColIndex: rightOnIndex}}, byCols...)
+	series := joinSeries(left, right, keyCols, joinedRows)
+	if err := renameJoinSeries(series, left.NumCols(), keyCols, options.LeftSuffix, options.RightSuffix); err != nil {
+		return nil, err
+	}
+
+	return NewBowWithMetadata(joinMetadata(left, right), series...)
+}
+
+// getAsOfValues returns the values of the column `colIndex` as int64,
+// and an error if its non-nil values are not sorted in ascending order.
+func (b *bow) getAsOfValues(colIndex int) ([]int64, error) {
+	values := make([]int64, b.NumRows())
+	prevRowIndex := -1
+	for rowIndex := b.GetNextRowIndex(colIndex, 0); rowIndex != -1; rowIndex = b.GetNextRowIndex(colIndex, rowIndex+1) {
+		values[rowIndex], _ = b.GetInt64(colIndex, rowIndex)
+		if prevRowIndex != -1 && values[rowIndex] < values[prevRowIndex] {
+			return nil, fmt.Errorf("not sorted in ascending order at row %d", rowIndex)
+		}
+		prevRowIndex = rowIndex
+	}
+	return values, nil
+}
+
+// findAsOfRow returns the row among `rightRows`, sorted by ascending `rightValues`,
+// matching the left value `leftValue` according to `options`, or -1 if none matches.
+func findAsOfRow(leftValue int64, rightValues []int64, rightRows []int, options AsOfJoinOptions) int {
+	// index of the first right row whose value is strictly greater than the left value
+	after := sort.Search(len(rightRows), func(i int) bool {
+		return rightValues[rightRows[i]] > leftValue
+	})
+
+	backward, forward := -1, -1
+	if after > 0 {
+		backward = rightRows[after-1]
+	}
+	if options.Direction != AsOfBackward {
+		// index of the first right row whose value is greater than or equal to the left value
+		first := sort.Search(after, func(i int) bool {
+			return rightValues[rightRows[i]] >= leftValue
+		})
+		if first < len(rightRows) {
+			forward = rightRows[first]
+		}
+	}
+
+	var res int
+	switch options.Direction {
+	case AsOfBackward:
+		res = backward
+	case AsOfForward:
+		res = forward
+	case AsOfNearest:
+		res = backward
+		if backward == -1 || (forward != -1 &&
+			getAsOfDistance(leftValue, rightValues[forward]) < getAsOfDistance(leftValue, rightValues[backward])) {
+			res = forward
+		}
+	}
+
+	if res != -1 && options.Tolerance != nil && getAsOfDistance(leftValue, rightValues[res]) > uint64(*options.Tolerance) {
+		return -1
+	}
+	return res
+}
+
+// getAsOfDistance returns the absolute difference between `a` and `b` as an uint64,
+// which cannot overflow, unlike the int64 subtraction of extreme values.
+func getAsOfDistance(a, b int64) uint64 {
+	if a < b {
+		a, b = b, a
+	}
+	return uint64(a) - uint64(b)
+}
@@ -0,0 +1,199 @@
+package bow
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBow_AsOfJoin(t *testing.T) {
+	tolerance := func(value int64) *int64 { return &value }
+
+	left, err := NewBowFromRowBasedInterfaces(
+		[]string{"time", "sensor", "a"},
+		[]Type{Int64, String, Float64}, [][]interface{}{
+			{1, "s1", 1.},
+			{5, "s2", 2.},
+			{nil, "s1", 3.},
+			{10, "s1", 4.},
+			{12, "s2", 5.},
+			{20, "s1", 6.},
+		})
+	require.NoError(t, err)
+	left = left.SetMetadata("k1", "v1")
+
+	right, err := NewBowFromRowBasedInterfaces(
+		[]string{"t", "sensor", "b"},
+		[]Type{Int64, String, Float64}, [][]interface{}{
+			{2, "s1", 10.},
+			{4, "s2", 20.},
+			{nil, "s1", 30.},
+			{8, "s1", 40.},
+			{12, "s2", 50.},
+			{12, "s2", 60.},
+			{17, "s1", 70.},
+		})
+	require.NoError(t, err)
+
+	for _, testCase := range []struct {
+		name     string
+		options  AsOfJoinOptions
+		cols     []string
+		types    []Type
+		expected [][]interface{}
+	}{
+		{
+			name:    "backward",
+			options: AsOfJoinOptions{LeftOn: "time", RightOn: "t"},
+			cols:    []string{"time", "sensor", "a", "sensor_right", "b"},
+			types:   []Type{Int64, String, Float64, String, Float64},
+			expected: [][]interface{}{
+				{1, "s1", 1., nil, nil},
+				{5, "s2", 2., "s2", 20.},
+				{nil, "s1", 3., nil, nil},
+				{10, "s1", 4., "s1", 40.},
+				{12, "s2", 5., "s2", 60.},
+				{20, "s1", 6., "s1", 70.},
+			},
+		},
+		{
+			name:    "forward by sensor",
+			options: AsOfJoinOptions{LeftOn: "time", RightOn: "t", Direction: AsOfForward, LeftBy: []string{"sensor"}},
+			cols:    []string{"time", "sensor", "a", "b"},
+			types:   []Type{Int64, String, Float64, Float64},
+			expected: [][]interface{}{
+				{1, "s1", 1., 10.},
+				{5, "s2", 2., 50.},
+				{nil, "s1", 3., nil},
+				{10, "s1", 4., 70.},
+				{12, "s2", 5., 50.},
+				{20, "s1", 6., nil},
+			},
+		},
+		{
+			name: "nearest by sensor with tolerance",
+			options: AsOfJoinOptions{LeftOn: "time", RightOn: "t", Direction: AsOfNearest,
+				Tolerance: tolerance(2), LeftBy: []string{"sensor"}},
+			cols:  []string{"time", "sensor", "a", "b"},
+			types: []Type{Int64, String, Float64, Float64},
+			expected: [][]interface{}{
+				{1, "s1", 1., 10.},
+				{5, "s2", 2., 20.},
+				{nil, "s1", 3., nil},
+				{10, "s1", 4., 40.},
+				{12, "s2", 5., 60.},
+				{20, "s1", 6., nil},
+			},
+		},
+		{
+			name: "exact matches by sensor",
+			options: AsOfJoinOptions{LeftOn: "time", RightOn: "t",
+				Tolerance: tolerance(0), LeftBy: []string{"sensor"}},
+			cols:  []string{"time", "sensor", "a", "b"},
+			types: []Type{Int64, String, Float64, Float64},
+			expected: [][]interface{}{
+				{1, "s1", 1., nil},
+				{5, "s2", 2., nil},
+				{nil, "s1", 3., nil},
+				{10, "s1", 4., nil},
+				{12, "s2", 5., 60.},
+				{20, "s1", 6., nil},
+			},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := left.AsOfJoin(right, testCase.options)
+			require.NoError(t, err)
+
+			expected, err := NewBowFromRowBasedInterfaces(testCase.cols, testCase.types, testCase.expected)
+			require.NoError(t, err)
+			expected = expected.SetMetadata("k1", "v1")
+			ExpectEqual(t, expected, res)
+		})
+	}
+
+	t.Run("timestamps", func(t *testing.T) {
+		left, err := NewBowFromRowBasedInterfaces(
+			[]string{"time", "a"},
+			[]Type{TimestampMilli, Int64}, [][]interface{}{
+				{1000, 1},
+				{3000, 2},
+			})
+		require.NoError(t, err)
+		right, err := NewBowFromRowBasedInterfaces(
+			[]string{"time", "a"},
+			[]Type{TimestampMilli, Int64}, [][]interface{}{
+				{2000, 10},
+			})
+		require.NoError(t, err)
+
+		res, err := left.AsOfJoin(right, AsOfJoinOptions{LeftOn: "time", LeftSuffix: "_l", RightSuffix: "_r"})
+		require.NoError(t, err)
+
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"time", "a_l", "a_r"},
+			[]Type{TimestampMilli, Int64, Int64}, [][]interface{}{
+				{1000, 1, nil},
+				{3000, 2, 10},
+			})
+		require.NoError(t, err)
+		ExpectEqual(t, expected, res)
+	})
+
+	t.Run("extreme values", func(t *testing.T) {
+		left, err := NewBow(NewSeries("time", Int64, []int64{math.MinInt64, math.MaxInt64}, nil))
+		require.NoError(t, err)
+		right, err := NewBow(
+			NewSeries("time", Int64, []int64{math.MinInt64, math.MaxInt64 - 1}, nil),
+			NewSeries("b", Int64, []int64{1, 2}, nil),
+		)
+		require.NoError(t, err)
+
+		res, err := left.AsOfJoin(right, AsOfJoinOptions{LeftOn: "time", Direction: AsOfNearest, Tolerance: tolerance(10)})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), res.GetValue(1, 0))
+		assert.Equal(t, int64(2), res.GetValue(1, 1))
+
+		right, err = NewBow(
+			NewSeries("time", Int64, []int64{math.MinInt64}, nil),
+			NewSeries("b", Int64, []int64{1}, nil),
+		)
+		require.NoError(t, err)
+
+		res, err = left.AsOfJoin(right, AsOfJoinOptions{LeftOn: "time", Tolerance: tolerance(10)})
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), res.GetValue(1, 0))
+		assert.Nil(t, res.GetValue(1, 1))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		unsorted, err := NewBowFromRowBasedInterfaces(
+			[]string{"t", "sensor", "b"},
+			[]Type{Int64, String, Float64}, [][]interface{}{
+				{2, "s1", 10.},
+				{1, "s1", 20.},
+			})
+		require.NoError(t, err)
+		_, err = left.AsOfJoin(unsorted, AsOfJoinOptions{LeftOn: "time", RightOn: "t"})
+		assert.Error(t, err)
+
+		for name, options := range map[string]AsOfJoinOptions{
+			"no column":          {},
+			"unknown column":     {LeftOn: "time"},
+			"unsupported type":   {LeftOn: "a", RightOn: "b"},
+			"incompatible types": {LeftOn: "time", RightOn: "b"},
+			"unknown direction":  {LeftOn: "time", RightOn: "t", Direction: AsOfDirection(42)},
+			"negative tolerance": {LeftOn: "time", RightOn: "t", Tolerance: tolerance(-1)},
+			"unknown key column": {LeftOn: "time", RightOn: "t", LeftBy: []string{"a"}},
+			"different key count": {LeftOn: "time", RightOn: "t",
+				LeftBy: []string{"sensor"}, RightBy: []string{"sensor", "b"}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := left.AsOfJoin(right, options)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
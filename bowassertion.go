@@ -1,6 +1,7 @@
 package bow
 
 import (
+	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/apache/arrow/go/v8/arrow/array"
 )
 
@@ -74,6 +75,57 @@ func (b *bow) IsColSorted(colIndex int) bool {
 			}
 			curr = next
 		}
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		arr := array.NewTimestampData(b.Column(colIndex).Data())
+		values := arr.TimestampValues()
+		for arr.IsNull(rowIndex) {
+			rowIndex++
+		}
+		curr := values[rowIndex]
+		var next arrow.Timestamp
+		rowIndex++
+		for ; rowIndex < len(values); rowIndex++ {
+			if !arr.IsValid(rowIndex) {
+				continue
+			}
+			next = values[rowIndex]
+			if order == orderUndefined {
+				if curr < next {
+					order = orderASC
+				} else if curr > next {
+					order = orderDESC
+				}
+			}
+			if order == orderASC && next < curr ||
+				order == orderDESC && next > curr {
+				return false
+			}
+			curr = next
+		}
+	case Int8, Int16, Int32, Uint8, Uint16, Uint32, Uint64, Float32, Decimal, Date, Duration:
+		buf := b.NewBufferFromCol(colIndex)
+		for buf.IsNull(rowIndex) {
+			rowIndex++
+		}
+		curr := rowIndex
+		rowIndex++
+		for ; rowIndex < buf.Len(); rowIndex++ {
+			if !buf.IsValid(rowIndex) {
+				continue
+			}
+			if order == orderUndefined {
+				if buf.Less(curr, rowIndex) {
+					order = orderASC
+				} else if buf.Less(rowIndex, curr) {
+					order = orderDESC
+				}
+			}
+			if order == orderASC && buf.Less(rowIndex, curr) ||
+				order == orderDESC && buf.Less(curr, rowIndex) {
+				return false
+			}
+			curr = rowIndex
+		}
 	default:
 		return false
 	}
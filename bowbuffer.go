@@ -1,9 +1,12 @@
 package bow
 
 import (
+	"bytes"
 	"fmt"
+	"math"
 	"sort"
 
+	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/apache/arrow/go/v8/arrow/array"
 	"github.com/apache/arrow/go/v8/arrow/bitutil"
 )
@@ -12,10 +15,12 @@ import (
 // - Data: slice of data.
 // - DataType: type of the data.
 // - nullBitmapBytes: slice of bytes representing valid or null values.
+// - timeZone: time zone of the timestamp column the Buffer is built from, set back on the Series built from it.
 type Buffer struct {
 	Data            interface{}
 	DataType        Type
 	nullBitmapBytes []byte
+	timeZone        string
 }
 
 // NewBuffer returns a new Buffer of size `size` and Type `typ`.
@@ -31,8 +36,38 @@ func NewBuffer(size int, typ Type) Buffer {
 		buf.Data = make([]float64, size)
 	case Boolean:
 		buf.Data = make([]bool, size)
-	case String:
+	case String, Dictionary:
 		buf.Data = make([]string, size)
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		buf.Data = make([]arrow.Timestamp, size)
+	case Int8:
+		buf.Data = make([]int8, size)
+	case Int16:
+		buf.Data = make([]int16, size)
+	case Int32:
+		buf.Data = make([]int32, size)
+	case Uint8:
+		buf.Data = make([]uint8, size)
+	case Uint16:
+		buf.Data = make([]uint16, size)
+	case Uint32:
+		buf.Data = make([]uint32, size)
+	case Uint64:
+		buf.Data = make([]uint64, size)
+	case Float32:
+		buf.Data = make([]float32, size)
+	case Decimal:
+		buf.Data = make([]Decimal128, size)
+	case List:
+		buf.Data = make([][]interface{}, size)
+	case Struct:
+		buf.Data = make([]map[string]interface{}, size)
+	case Binary:
+		buf.Data = make([][]byte, size)
+	case Date:
+		buf.Data = make([]arrow.Date32, size)
+	case Duration:
+		buf.Data = make([]arrow.Duration, size)
 	default:
 		panic(fmt.Errorf("unsupported type '%s'", typ))
 	}
@@ -48,8 +83,38 @@ func (b Buffer) Len() int {
 		return len(b.Data.([]float64))
 	case Boolean:
 		return len(b.Data.([]bool))
-	case String:
+	case String, Dictionary:
 		return len(b.Data.([]string))
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		return len(b.Data.([]arrow.Timestamp))
+	case Int8:
+		return len(b.Data.([]int8))
+	case Int16:
+		return len(b.Data.([]int16))
+	case Int32:
+		return len(b.Data.([]int32))
+	case Uint8:
+		return len(b.Data.([]uint8))
+	case Uint16:
+		return len(b.Data.([]uint16))
+	case Uint32:
+		return len(b.Data.([]uint32))
+	case Uint64:
+		return len(b.Data.([]uint64))
+	case Float32:
+		return len(b.Data.([]float32))
+	case Decimal:
+		return len(b.Data.([]Decimal128))
+	case List:
+		return len(b.Data.([][]interface{}))
+	case Struct:
+		return len(b.Data.([]map[string]interface{}))
+	case Binary:
+		return len(b.Data.([][]byte))
+	case Date:
+		return len(b.Data.([]arrow.Date32))
+	case Duration:
+		return len(b.Data.([]arrow.Duration))
 	default:
 		panic(fmt.Errorf("unsupported type '%s'", b.DataType))
 	}
@@ -66,8 +131,38 @@ func (b *Buffer) SetOrDrop(i int, value interface{}) {
 		b.Data.([]float64)[i], valid = Float64.Convert(value).(float64)
 	case Boolean:
 		b.Data.([]bool)[i], valid = Boolean.Convert(value).(bool)
-	case String:
+	case String, Dictionary:
 		b.Data.([]string)[i], valid = String.Convert(value).(string)
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		b.Data.([]arrow.Timestamp)[i], valid = b.DataType.Convert(value).(arrow.Timestamp)
+	case Int8:
+		b.Data.([]int8)[i], valid = Int8.Convert(value).(int8)
+	case Int16:
+		b.Data.([]int16)[i], valid = Int16.Convert(value).(int16)
+	case Int32:
+		b.Data.([]int32)[i], valid = Int32.Convert(value).(int32)
+	case Uint8:
+		b.Data.([]uint8)[i], valid = Uint8.Convert(value).(uint8)
+	case Uint16:
+		b.Data.([]uint16)[i], valid = Uint16.Convert(value).(uint16)
+	case Uint32:
+		b.Data.([]uint32)[i], valid = Uint32.Convert(value).(uint32)
+	case Uint64:
+		b.Data.([]uint64)[i], valid = Uint64.Convert(value).(uint64)
+	case Float32:
+		b.Data.([]float32)[i], valid = Float32.Convert(value).(float32)
+	case Decimal:
+		b.Data.([]Decimal128)[i], valid = Decimal.Convert(value).(Decimal128)
+	case List:
+		b.Data.([][]interface{})[i], valid = List.Convert(value).([]interface{})
+	case Struct:
+		b.Data.([]map[string]interface{})[i], valid = Struct.Convert(value).(map[string]interface{})
+	case Binary:
+		b.Data.([][]byte)[i], valid = Binary.Convert(value).([]byte)
+	case Date:
+		b.Data.([]arrow.Date32)[i], valid = Date.Convert(value).(arrow.Date32)
+	case Duration:
+		b.Data.([]arrow.Duration)[i], valid = Duration.Convert(value).(arrow.Duration)
 	default:
 		panic(fmt.Errorf("unsupported type '%s'", b.DataType))
 	}
@@ -90,8 +185,38 @@ func (b *Buffer) SetOrDropStrict(i int, value interface{}) {
 		b.Data.([]float64)[i], valid = value.(float64)
 	case Boolean:
 		b.Data.([]bool)[i], valid = value.(bool)
-	case String:
+	case String, Dictionary:
 		b.Data.([]string)[i], valid = value.(string)
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		b.Data.([]arrow.Timestamp)[i], valid = value.(arrow.Timestamp)
+	case Int8:
+		b.Data.([]int8)[i], valid = value.(int8)
+	case Int16:
+		b.Data.([]int16)[i], valid = value.(int16)
+	case Int32:
+		b.Data.([]int32)[i], valid = value.(int32)
+	case Uint8:
+		b.Data.([]uint8)[i], valid = value.(uint8)
+	case Uint16:
+		b.Data.([]uint16)[i], valid = value.(uint16)
+	case Uint32:
+		b.Data.([]uint32)[i], valid = value.(uint32)
+	case Uint64:
+		b.Data.([]uint64)[i], valid = value.(uint64)
+	case Float32:
+		b.Data.([]float32)[i], valid = value.(float32)
+	case Decimal:
+		b.Data.([]Decimal128)[i], valid = value.(Decimal128)
+	case List:
+		b.Data.([][]interface{})[i], valid = value.([]interface{})
+	case Struct:
+		b.Data.([]map[string]interface{})[i], valid = value.(map[string]interface{})
+	case Binary:
+		b.Data.([][]byte)[i], valid = value.([]byte)
+	case Date:
+		b.Data.([]arrow.Date32)[i], valid = value.(arrow.Date32)
+	case Duration:
+		b.Data.([]arrow.Duration)[i], valid = value.(arrow.Duration)
 	default:
 		panic(fmt.Errorf("unsupported type '%s'", b.DataType))
 	}
@@ -116,23 +241,95 @@ func (b *Buffer) GetValue(i int) interface{} {
 		return b.Data.([]float64)[i]
 	case Boolean:
 		return b.Data.([]bool)[i]
-	case String:
+	case String, Dictionary:
 		return b.Data.([]string)[i]
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		return b.Data.([]arrow.Timestamp)[i]
+	case Int8:
+		return b.Data.([]int8)[i]
+	case Int16:
+		return b.Data.([]int16)[i]
+	case Int32:
+		return b.Data.([]int32)[i]
+	case Uint8:
+		return b.Data.([]uint8)[i]
+	case Uint16:
+		return b.Data.([]uint16)[i]
+	case Uint32:
+		return b.Data.([]uint32)[i]
+	case Uint64:
+		return b.Data.([]uint64)[i]
+	case Float32:
+		return b.Data.([]float32)[i]
+	case Decimal:
+		return b.Data.([]Decimal128)[i]
+	case List:
+		return b.Data.([][]interface{})[i]
+	case Struct:
+		return b.Data.([]map[string]interface{})[i]
+	case Binary:
+		return b.Data.([][]byte)[i]
+	case Date:
+		return b.Data.([]arrow.Date32)[i]
+	case Duration:
+		return b.Data.([]arrow.Duration)[i]
 	default:
 		panic(fmt.Errorf("unsupported type '%s'", b.DataType))
 	}
 }
 
+// isNaN returns true if the value at row `rowIndex` is a Float64 or Float32 NaN.
+func (b Buffer) isNaN(rowIndex int) bool {
+	switch data := b.Data.(type) {
+	case []float64:
+		return math.IsNaN(data[rowIndex])
+	case []float32:
+		return math.IsNaN(float64(data[rowIndex]))
+	}
+	return false
+}
+
 func (b Buffer) Less(i, j int) bool {
 	switch b.DataType {
 	case Int64:
 		return b.Data.([]int64)[i] < b.Data.([]int64)[j]
 	case Float64:
 		return b.Data.([]float64)[i] < b.Data.([]float64)[j]
-	case String:
+	case String, Dictionary:
 		return b.Data.([]string)[i] < b.Data.([]string)[j]
 	case Boolean:
 		return !b.Data.([]bool)[i] && b.Data.([]bool)[j]
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		return b.Data.([]arrow.Timestamp)[i] < b.Data.([]arrow.Timestamp)[j]
+	case Int8:
+		return b.Data.([]int8)[i] < b.Data.([]int8)[j]
+	case Int16:
+		return b.Data.([]int16)[i] < b.Data.([]int16)[j]
+	case Int32:
+		return b.Data.([]int32)[i] < b.Data.([]int32)[j]
+	case Uint8:
+		return b.Data.([]uint8)[i] < b.Data.([]uint8)[j]
+	case Uint16:
+		return b.Data.([]uint16)[i] < b.Data.([]uint16)[j]
+	case Uint32:
+		return b.Data.([]uint32)[i] < b.Data.([]uint32)[j]
+	case Uint64:
+		return b.Data.([]uint64)[i] < b.Data.([]uint64)[j]
+	case Float32:
+		return b.Data.([]float32)[i] < b.Data.([]float32)[j]
+	case Decimal:
+		return b.Data.([]Decimal128)[i].Cmp(b.Data.([]Decimal128)[j]) < 0
+	case List:
+		// Nested values have no natural order, their string representations are compared instead
+		return fmt.Sprint(b.Data.([][]interface{})[i]) < fmt.Sprint(b.Data.([][]interface{})[j])
+	case Struct:
+		return fmt.Sprint(b.Data.([]map[string]interface{})[i]) < fmt.Sprint(b.Data.([]map[string]interface{})[j])
+	case Binary:
+		return bytes.Compare(b.Data.([][]byte)[i], b.Data.([][]byte)[j]) < 0
+	case Date:
+		return b.Data.([]arrow.Date32)[i] < b.Data.([]arrow.Date32)[j]
+	case Duration:
+		return b.Data.([]arrow.Duration)[i] < b.Data.([]arrow.Duration)[j]
 	default:
 		panic(fmt.Errorf("unsupported type '%s'", b.DataType))
 	}
@@ -140,42 +337,85 @@ func (b Buffer) Less(i, j int) bool {
 
 func (b *bow) NewBufferFromCol(colIndex int) Buffer {
 	data := b.Column(colIndex).Data()
-	res := Buffer{DataType: b.ColumnType(colIndex)}
+	res := Buffer{
+		DataType:        b.ColumnType(colIndex),
+		nullBitmapBytes: copyNullBitmapBytes(b.Column(colIndex)),
+	}
+	if timestampType, ok := b.Column(colIndex).DataType().(*arrow.TimestampType); ok {
+		res.timeZone = timestampType.TimeZone
+	}
 	switch b.ColumnType(colIndex) {
 	case Int64:
-		arr := array.NewInt64Data(data)
-		nullBitmapBytes := arr.NullBitmapBytes()[:bitutil.CeilByte(arr.Data().Len())/8]
-		nullBitmapBytesCopy := make([]byte, len(nullBitmapBytes))
-		copy(nullBitmapBytesCopy, nullBitmapBytes)
-		res.Data = int64Values(arr)
-		res.nullBitmapBytes = nullBitmapBytesCopy
+		res.Data = int64Values(array.NewInt64Data(data))
 	case Float64:
-		arr := array.NewFloat64Data(data)
-		nullBitmapBytes := arr.NullBitmapBytes()[:bitutil.CeilByte(arr.Data().Len())/8]
-		nullBitmapBytesCopy := make([]byte, len(nullBitmapBytes))
-		copy(nullBitmapBytesCopy, nullBitmapBytes)
-		res.Data = float64Values(arr)
-		res.nullBitmapBytes = nullBitmapBytesCopy
+		res.Data = float64Values(array.NewFloat64Data(data))
 	case Boolean:
-		arr := array.NewBooleanData(data)
-		nullBitmapBytes := arr.NullBitmapBytes()[:bitutil.CeilByte(arr.Data().Len())/8]
-		nullBitmapBytesCopy := make([]byte, len(nullBitmapBytes))
-		copy(nullBitmapBytesCopy, nullBitmapBytes)
-		res.Data = booleanValues(arr)
-		res.nullBitmapBytes = nullBitmapBytesCopy
+		res.Data = booleanValues(array.NewBooleanData(data))
 	case String:
-		arr := array.NewStringData(data)
-		nullBitmapBytes := arr.NullBitmapBytes()[:bitutil.CeilByte(arr.Data().Len())/8]
-		nullBitmapBytesCopy := make([]byte, len(nullBitmapBytes))
-		copy(nullBitmapBytesCopy, nullBitmapBytes)
-		res.Data = stringValues(arr)
-		res.nullBitmapBytes = nullBitmapBytesCopy
+		res.Data = stringValues(array.NewStringData(data))
+	case Dictionary:
+		res.Data = dictionaryValues(array.NewDictionaryData(data))
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		res.Data = timestampValues(array.NewTimestampData(data))
+	case Int8:
+		res.Data = array.NewInt8Data(data).Int8Values()
+	case Int16:
+		res.Data = array.NewInt16Data(data).Int16Values()
+	case Int32:
+		res.Data = array.NewInt32Data(data).Int32Values()
+	case Uint8:
+		res.Data = array.NewUint8Data(data).Uint8Values()
+	case Uint16:
+		res.Data = array.NewUint16Data(data).Uint16Values()
+	case Uint32:
+		res.Data = array.NewUint32Data(data).Uint32Values()
+	case Uint64:
+		res.Data = array.NewUint64Data(data).Uint64Values()
+	case Float32:
+		res.Data = array.NewFloat32Data(data).Float32Values()
+	case Decimal:
+		res.Data = decimalValues(array.NewDecimal128Data(data))
+	case List:
+		res.Data = listValues(array.NewListData(data))
+	case Struct:
+		res.Data = structValues(array.NewStructData(data))
+	case Binary:
+		res.Data = binaryValues(array.NewBinaryData(data))
+	case Date:
+		res.Data = array.NewDate32Data(data).Date32Values()
+	case Duration:
+		res.Data = array.NewDurationData(data).DurationValues()
 	default:
 		panic(fmt.Errorf("unsupported type '%s'", b.ColumnType(colIndex)))
 	}
 	return res
 }
 
+// copyNullBitmapBytes returns a copy of the null bitmap of the array, aligned on its first value.
+func copyNullBitmapBytes(arr arrow.Array) []byte {
+	res := make([]byte, bitutil.CeilByte(arr.Len())/8)
+	nullBitmapBytes := arr.NullBitmapBytes()
+	if nullBitmapBytes == nil {
+		for i := 0; i < arr.Len(); i++ {
+			bitutil.SetBit(res, i)
+		}
+		return res
+	}
+
+	offset := arr.Data().Offset()
+	if offset%8 == 0 {
+		copy(res, nullBitmapBytes[offset/8:])
+		return res
+	}
+
+	for i := 0; i < arr.Len(); i++ {
+		if bitutil.BitIsSet(nullBitmapBytes, offset+i) {
+			bitutil.SetBit(res, i)
+		}
+	}
+	return res
+}
+
 // NewBufferFromInterfaces returns a new typed Buffer with the data represented as a slice of interface{}, with eventual nil values.
 func NewBufferFromInterfaces(typ Type, data []interface{}) (Buffer, error) {
 	buf := NewBuffer(len(data), typ)
@@ -204,3 +444,62 @@ func (b Buffer) Swap(i, j int) {
 	b.SetOrDropStrict(i, v2)
 	b.SetOrDropStrict(j, v1)
 }
+
+// newBufferFromIndices returns a new Buffer holding the values of the Buffer at each of the row `indices`,
+// gathered in a single pass over the typed data. A negative index gives a nil value.
+func (b Buffer) newBufferFromIndices(indices []int) Buffer {
+	res := NewBuffer(len(indices), b.DataType)
+	res.timeZone = b.timeZone
+	for i, index := range indices {
+		if index >= 0 && b.IsValid(index) {
+			bitutil.SetBit(res.nullBitmapBytes, i)
+		}
+	}
+
+	switch data := b.Data.(type) {
+	case []int64:
+		gathered := res.Data.([]int64)
+		for i, index := range indices {
+			if index >= 0 {
+				gathered[i] = data[index]
+			}
+		}
+	case []float64:
+		gathered := res.Data.([]float64)
+		for i, index := range indices {
+			if index >= 0 {
+				gathered[i] = data[index]
+			}
+		}
+	case []bool:
+		gathered := res.Data.([]bool)
+		for i, index := range indices {
+			if index >= 0 {
+				gathered[i] = data[index]
+			}
+		}
+	case []string:
+		gathered := res.Data.([]string)
+		for i, index := range indices {
+			if index >= 0 {
+				gathered[i] = data[index]
+			}
+		}
+	case []arrow.Timestamp:
+		gathered := res.Data.([]arrow.Timestamp)
+		for i, index := range indices {
+			if index >= 0 {
+				gathered[i] = data[index]
+			}
+		}
+	default:
+		// Less common types are gathered value by value
+		for i, index := range indices {
+			if index >= 0 {
+				res.SetOrDropStrict(i, b.GetValue(index))
+			}
+		}
+	}
+
+	return res
+}
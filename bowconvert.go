@@ -3,7 +3,14 @@ package bow
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/big"
+	"reflect"
 	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/decimal128"
 )
 
 // ToInt64 attempts to convert `input` to int64.
@@ -23,6 +30,27 @@ func ToInt64(input interface{}) (output int64, ok bool) {
 		return int64(input), true
 	case int64:
 		return input, true
+	case arrow.Timestamp:
+		return int64(input), true
+	case arrow.Date32:
+		return int64(input), true
+	case arrow.Duration:
+		return int64(input), true
+	case time.Duration:
+		return int64(input), true
+	case Decimal128:
+		output := new(big.Int).Quo(input.BigInt(), decimalScaleMultiplier)
+		return output.Int64(), output.IsInt64()
+	case uint:
+		return int64(input), input <= math.MaxInt64
+	case uint8:
+		return int64(input), true
+	case uint16:
+		return int64(input), true
+	case uint32:
+		return int64(input), true
+	case uint64:
+		return int64(input), input <= math.MaxInt64
 	case float32:
 		return int64(input), true
 	case float64:
@@ -58,6 +86,27 @@ func ToFloat64(input interface{}) (output float64, ok bool) {
 		return float64(input), true
 	case int64:
 		return float64(input), true
+	case arrow.Timestamp:
+		return float64(input), true
+	case arrow.Date32:
+		return float64(input), true
+	case arrow.Duration:
+		return float64(input), true
+	case time.Duration:
+		return float64(input), true
+	case Decimal128:
+		output, _ := input.Rat().Float64()
+		return output, true
+	case uint:
+		return float64(input), true
+	case uint8:
+		return float64(input), true
+	case uint16:
+		return float64(input), true
+	case uint32:
+		return float64(input), true
+	case uint64:
+		return float64(input), true
 	case float32:
 		return float64(input), true
 	case bool:
@@ -95,10 +144,22 @@ func ToBoolean(input interface{}) (output bool, ok bool) {
 		return input != 0, true
 	case int64:
 		return input != 0, true
+	case uint:
+		return input != 0, true
+	case uint8:
+		return input != 0, true
+	case uint16:
+		return input != 0, true
+	case uint32:
+		return input != 0, true
+	case uint64:
+		return input != 0, true
 	case float32:
 		return input != 0., true
 	case float64:
 		return input != 0., true
+	case Decimal128:
+		return input.Sign() != 0, true
 	}
 	return
 }
@@ -126,10 +187,330 @@ func ToString(input interface{}) (output string, ok bool) {
 		return strconv.Itoa(int(input)), true
 	case int64:
 		return strconv.Itoa(int(input)), true
+	case arrow.Timestamp:
+		return strconv.Itoa(int(input)), true
+	case arrow.Date32:
+		return input.ToTime().Format(dateLayout), true
+	case arrow.Duration:
+		return time.Duration(input).String(), true
+	case time.Duration:
+		return input.String(), true
+	case uint:
+		return strconv.FormatUint(uint64(input), 10), true
+	case uint8:
+		return strconv.FormatUint(uint64(input), 10), true
+	case uint16:
+		return strconv.FormatUint(uint64(input), 10), true
+	case uint32:
+		return strconv.FormatUint(uint64(input), 10), true
+	case uint64:
+		return strconv.FormatUint(input, 10), true
 	case float32:
 		return fmt.Sprintf("%f", input), true
 	case float64:
 		return fmt.Sprintf("%f", input), true
+	case Decimal128:
+		return input.String(), true
 	}
 	return
 }
+
+// ToInt8 attempts to convert `input` to int8.
+// Return also a false boolean if the conversion failed or if the value overflows int8.
+func ToInt8(input interface{}) (output int8, ok bool) {
+	v, ok := ToInt64(input)
+	if !ok || v < math.MinInt8 || v > math.MaxInt8 {
+		return 0, false
+	}
+	return int8(v), true
+}
+
+// ToInt16 attempts to convert `input` to int16.
+// Return also a false boolean if the conversion failed or if the value overflows int16.
+func ToInt16(input interface{}) (output int16, ok bool) {
+	v, ok := ToInt64(input)
+	if !ok || v < math.MinInt16 || v > math.MaxInt16 {
+		return 0, false
+	}
+	return int16(v), true
+}
+
+// ToInt32 attempts to convert `input` to int32.
+// Return also a false boolean if the conversion failed or if the value overflows int32.
+func ToInt32(input interface{}) (output int32, ok bool) {
+	v, ok := ToInt64(input)
+	if !ok || v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, false
+	}
+	return int32(v), true
+}
+
+// ToUint8 attempts to convert `input` to uint8.
+// Return also a false boolean if the conversion failed or if the value overflows uint8.
+func ToUint8(input interface{}) (output uint8, ok bool) {
+	v, ok := ToUint64(input)
+	if !ok || v > math.MaxUint8 {
+		return 0, false
+	}
+	return uint8(v), true
+}
+
+// ToUint16 attempts to convert `input` to uint16.
+// Return also a false boolean if the conversion failed or if the value overflows uint16.
+func ToUint16(input interface{}) (output uint16, ok bool) {
+	v, ok := ToUint64(input)
+	if !ok || v > math.MaxUint16 {
+		return 0, false
+	}
+	return uint16(v), true
+}
+
+// ToUint32 attempts to convert `input` to uint32.
+// Return also a false boolean if the conversion failed or if the value overflows uint32.
+func ToUint32(input interface{}) (output uint32, ok bool) {
+	v, ok := ToUint64(input)
+	if !ok || v > math.MaxUint32 {
+		return 0, false
+	}
+	return uint32(v), true
+}
+
+// ToUint64 attempts to convert `input` to uint64.
+// Return also a false boolean if the conversion failed or if the value is negative.
+func ToUint64(input interface{}) (output uint64, ok bool) {
+	switch input := input.(type) {
+	case uint:
+		return uint64(input), true
+	case uint8:
+		return uint64(input), true
+	case uint16:
+		return uint64(input), true
+	case uint32:
+		return uint64(input), true
+	case uint64:
+		return input, true
+	case json.Number:
+		output, err := strconv.ParseUint(input.String(), 10, 64)
+		if err == nil {
+			return output, true
+		}
+	case string:
+		output, err := strconv.ParseUint(input, 10, 64)
+		return output, err == nil
+	}
+	v, ok := ToInt64(input)
+	if !ok || v < 0 {
+		return 0, false
+	}
+	return uint64(v), true
+}
+
+// ToFloat32 attempts to convert `input` to float32.
+// Return also a false boolean if the conversion failed.
+func ToFloat32(input interface{}) (output float32, ok bool) {
+	if input, isFloat32 := input.(float32); isFloat32 {
+		return input, true
+	}
+	v, ok := ToFloat64(input)
+	return float32(v), ok
+}
+
+// ToTimestamp attempts to convert `input` to arrow.Timestamp in the time unit `unit`.
+// Return also a false boolean if the conversion failed.
+// Numeric values are considered as already expressed in `unit`,
+// while time.Time values and strings are converted into `unit`.
+// Strings are parsed as RFC3339 dates, or as integers if this parsing fails.
+func ToTimestamp(input interface{}, unit arrow.TimeUnit) (output arrow.Timestamp, ok bool) {
+	switch input := input.(type) {
+	case arrow.Timestamp:
+		return input, true
+	case time.Time:
+		return timeToTimestamp(input, unit), true
+	case arrow.Date32:
+		return timeToTimestamp(input.ToTime(), unit), true
+	case string:
+		t, err := time.Parse(time.RFC3339Nano, input)
+		if err == nil {
+			return timeToTimestamp(t, unit), true
+		}
+		output, ok := ToInt64(input)
+		return arrow.Timestamp(output), ok
+	case bool:
+		return
+	}
+	v, ok := ToInt64(input)
+	return arrow.Timestamp(v), ok
+}
+
+// ToDecimal attempts to convert `input` to Decimal128.
+// Return also a false boolean if the conversion failed or if the value exceeds DecimalPrecision digits.
+// Values with more than DecimalScale digits after the decimal point are rounded half away from zero.
+// Strings are parsed exactly, as decimal or scientific notations.
+func ToDecimal(input interface{}) (output Decimal128, ok bool) {
+	switch input := input.(type) {
+	case Decimal128:
+		return input, true
+	case decimal128.Num:
+		return Decimal128(input), true
+	case json.Number:
+		return ToDecimal(input.String())
+	case string:
+		rat, ok := new(big.Rat).SetString(input)
+		if !ok {
+			return output, false
+		}
+		return ratToDecimal128(rat)
+	case float32:
+		return ToDecimal(float64(input))
+	case float64:
+		if math.IsNaN(input) || math.IsInf(input, 0) {
+			return output, false
+		}
+		return ratToDecimal128(new(big.Rat).SetFloat64(input))
+	case uint64:
+		return ratToDecimal128(new(big.Rat).SetInt(new(big.Int).SetUint64(input)))
+	case uint:
+		return ToDecimal(uint64(input))
+	case bool:
+		return
+	}
+	v, ok := ToInt64(input)
+	if !ok {
+		return output, false
+	}
+	return ratToDecimal128(new(big.Rat).SetInt64(v))
+}
+
+// ToList attempts to convert `input` to []interface{}.
+// Return also a false boolean if `input` is not a slice or an array.
+func ToList(input interface{}) (output []interface{}, ok bool) {
+	if input, isList := input.([]interface{}); isList {
+		return input, true
+	}
+
+	value := reflect.ValueOf(input)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return nil, false
+	}
+	output = make([]interface{}, value.Len())
+	for i := range output {
+		output[i] = value.Index(i).Interface()
+	}
+	return output, true
+}
+
+// ToStruct attempts to convert `input` to map[string]interface{}.
+// Return also a false boolean if `input` is not a map with string keys.
+func ToStruct(input interface{}) (output map[string]interface{}, ok bool) {
+	if input, isStruct := input.(map[string]interface{}); isStruct {
+		return input, true
+	}
+
+	value := reflect.ValueOf(input)
+	if value.Kind() != reflect.Map || value.Type().Key().Kind() != reflect.String {
+		return nil, false
+	}
+	output = make(map[string]interface{}, value.Len())
+	iter := value.MapRange()
+	for iter.Next() {
+		output[iter.Key().String()] = iter.Value().Interface()
+	}
+	return output, true
+}
+
+// ToBinary attempts to convert `input` to []byte.
+// Return also a false boolean if the conversion failed.
+// Strings are converted to their raw bytes.
+func ToBinary(input interface{}) (output []byte, ok bool) {
+	switch input := input.(type) {
+	case []byte:
+		return input, true
+	case string:
+		return []byte(input), true
+	}
+	return
+}
+
+// ToDate attempts to convert `input` to arrow.Date32.
+// Return also a false boolean if the conversion failed.
+// Numeric values are considered as days since the UNIX epoch,
+// while time.Time values are truncated to their date in their own location.
+// Strings are parsed as `2006-01-02` or RFC3339 dates, or as integers if both parsings fail.
+func ToDate(input interface{}) (output arrow.Date32, ok bool) {
+	switch input := input.(type) {
+	case arrow.Date32:
+		return input, true
+	case time.Time:
+		return timeToDate(input), true
+	case string:
+		if t, err := time.Parse(dateLayout, input); err == nil {
+			return timeToDate(t), true
+		}
+		if t, err := time.Parse(time.RFC3339Nano, input); err == nil {
+			return timeToDate(t), true
+		}
+	case bool:
+		return
+	}
+	v, ok := ToInt64(input)
+	if !ok || v < math.MinInt32 || v > math.MaxInt32 {
+		return 0, false
+	}
+	return arrow.Date32(v), true
+}
+
+// ToDuration attempts to convert `input` to arrow.Duration in nanoseconds.
+// Return also a false boolean if the conversion failed.
+// Numeric values are considered as nanoseconds.
+// Strings are parsed with time.ParseDuration, or as integers if this parsing fails.
+func ToDuration(input interface{}) (output arrow.Duration, ok bool) {
+	switch input := input.(type) {
+	case arrow.Duration:
+		return input, true
+	case time.Duration:
+		return arrow.Duration(input), true
+	case string:
+		if d, err := time.ParseDuration(input); err == nil {
+			return arrow.Duration(d), true
+		}
+	case bool:
+		return
+	}
+	v, ok := ToInt64(input)
+	return arrow.Duration(v), ok
+}
+
+func timeToTimestamp(t time.Time, unit arrow.TimeUnit) arrow.Timestamp {
+	switch unit {
+	case arrow.Second:
+		return arrow.Timestamp(t.Unix())
+	case arrow.Millisecond:
+		return arrow.Timestamp(t.UnixMilli())
+	case arrow.Microsecond:
+		return arrow.Timestamp(t.UnixMicro())
+	default:
+		return arrow.Timestamp(t.UnixNano())
+	}
+}
+
+func timestampToTime(ts arrow.Timestamp, unit arrow.TimeUnit) time.Time {
+	switch unit {
+	case arrow.Second:
+		return time.Unix(int64(ts), 0).UTC()
+	case arrow.Millisecond:
+		return time.UnixMilli(int64(ts)).UTC()
+	case arrow.Microsecond:
+		return time.UnixMicro(int64(ts)).UTC()
+	default:
+		return time.Unix(0, int64(ts)).UTC()
+	}
+}
+
+// dateLayout is the time layout of Date values represented as strings.
+const dateLayout = "2006-01-02"
+
+// timeToDate returns the date of `t` in its location, as days since the UNIX epoch.
+func timeToDate(t time.Time) arrow.Date32 {
+	year, month, day := t.Date()
+	return arrow.Date32(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
@@ -1,7 +1,12 @@
 package bow
 
 import (
+	"encoding/json"
+	"math"
 	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v8/arrow"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -124,3 +129,190 @@ func TestToString(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, "0", v)
 }
+
+func TestToNarrowerNumericTypes(t *testing.T) {
+	t.Run("int8", func(t *testing.T) {
+		v, ok := ToInt8(int64(-128))
+		require.True(t, ok)
+		assert.Equal(t, int8(-128), v)
+
+		_, ok = ToInt8(128)
+		assert.False(t, ok)
+	})
+
+	t.Run("int32", func(t *testing.T) {
+		v, ok := ToInt32("42")
+		require.True(t, ok)
+		assert.Equal(t, int32(42), v)
+
+		_, ok = ToInt32(int64(math.MaxInt32) + 1)
+		assert.False(t, ok)
+	})
+
+	t.Run("uint16", func(t *testing.T) {
+		v, ok := ToUint16(true)
+		require.True(t, ok)
+		assert.Equal(t, uint16(1), v)
+
+		_, ok = ToUint16(-1)
+		assert.False(t, ok)
+	})
+
+	t.Run("uint64", func(t *testing.T) {
+		v, ok := ToUint64(uint64(math.MaxUint64))
+		require.True(t, ok)
+		assert.Equal(t, uint64(math.MaxUint64), v)
+
+		v, ok = ToUint64("18446744073709551615")
+		require.True(t, ok)
+		assert.Equal(t, uint64(math.MaxUint64), v)
+
+		v, ok = ToUint64(2.)
+		require.True(t, ok)
+		assert.Equal(t, uint64(2), v)
+
+		_, ok = ToUint64(-1)
+		assert.False(t, ok)
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		v, ok := ToFloat32(float32(1.5))
+		require.True(t, ok)
+		assert.Equal(t, float32(1.5), v)
+
+		v, ok = ToFloat32("1.5")
+		require.True(t, ok)
+		assert.Equal(t, float32(1.5), v)
+	})
+}
+
+func TestToTimestamp(t *testing.T) {
+	var v arrow.Timestamp
+	var ok bool
+
+	v, ok = ToTimestamp(1, arrow.Millisecond)
+	require.True(t, ok)
+	assert.Equal(t, arrow.Timestamp(1), v)
+
+	v, ok = ToTimestamp(1.5, arrow.Millisecond)
+	require.True(t, ok)
+	assert.Equal(t, arrow.Timestamp(1), v)
+
+	v, ok = ToTimestamp("1", arrow.Second)
+	require.True(t, ok)
+	assert.Equal(t, arrow.Timestamp(1), v)
+
+	v, ok = ToTimestamp("1970-01-01T00:00:01Z", arrow.Millisecond)
+	require.True(t, ok)
+	assert.Equal(t, arrow.Timestamp(1000), v)
+
+	v, ok = ToTimestamp(time.Unix(1, 0), arrow.Microsecond)
+	require.True(t, ok)
+	assert.Equal(t, arrow.Timestamp(1000000), v)
+
+	_, ok = ToTimestamp(true, arrow.Second)
+	require.False(t, ok)
+
+	_, ok = ToTimestamp("not a time", arrow.Second)
+	require.False(t, ok)
+}
+
+func TestToDate(t *testing.T) {
+	var v arrow.Date32
+	var ok bool
+
+	v, ok = ToDate(1)
+	require.True(t, ok)
+	assert.Equal(t, arrow.Date32(1), v)
+
+	v, ok = ToDate("1970-01-11")
+	require.True(t, ok)
+	assert.Equal(t, arrow.Date32(10), v)
+
+	v, ok = ToDate("1969-12-31T23:00:00Z")
+	require.True(t, ok)
+	assert.Equal(t, arrow.Date32(-1), v)
+
+	v, ok = ToDate(time.Date(1970, 1, 2, 23, 0, 0, 0, time.FixedZone("UTC+2", 2*3600)))
+	require.True(t, ok)
+	assert.Equal(t, arrow.Date32(1), v)
+
+	_, ok = ToDate(int64(1) << 40)
+	require.False(t, ok)
+
+	_, ok = ToDate(true)
+	require.False(t, ok)
+
+	str, ok := ToString(arrow.Date32(10))
+	require.True(t, ok)
+	assert.Equal(t, "1970-01-11", str)
+}
+
+func TestToDuration(t *testing.T) {
+	var v arrow.Duration
+	var ok bool
+
+	v, ok = ToDuration(1)
+	require.True(t, ok)
+	assert.Equal(t, arrow.Duration(1), v)
+
+	v, ok = ToDuration(time.Minute)
+	require.True(t, ok)
+	assert.Equal(t, arrow.Duration(time.Minute), v)
+
+	v, ok = ToDuration("1h30m")
+	require.True(t, ok)
+	assert.Equal(t, arrow.Duration(90*time.Minute), v)
+
+	_, ok = ToDuration("not a duration")
+	require.False(t, ok)
+
+	_, ok = ToDuration(true)
+	require.False(t, ok)
+
+	str, ok := ToString(arrow.Duration(90 * time.Minute))
+	require.True(t, ok)
+	assert.Equal(t, "1h30m0s", str)
+}
+
+func TestToDecimal(t *testing.T) {
+	var v Decimal128
+	var ok bool
+
+	v, ok = ToDecimal(int64(12))
+	require.True(t, ok)
+	assert.Equal(t, "12", v.String())
+
+	v, ok = ToDecimal("12345678901234567890123456789.123456789")
+	require.True(t, ok)
+	assert.Equal(t, "12345678901234567890123456789.123456789", v.String())
+
+	v, ok = ToDecimal("-0.0000000015")
+	require.True(t, ok)
+	assert.Equal(t, "-0.000000002", v.String())
+
+	v, ok = ToDecimal(0.1)
+	require.True(t, ok)
+	assert.Equal(t, "0.1", v.String())
+
+	v, ok = ToDecimal(json.Number("1e3"))
+	require.True(t, ok)
+	assert.Equal(t, "1000", v.String())
+
+	_, ok = ToDecimal("123456789012345678901234567890")
+	require.False(t, ok)
+
+	_, ok = ToDecimal("not a number")
+	require.False(t, ok)
+
+	_, ok = ToDecimal(true)
+	require.False(t, ok)
+
+	i, ok := ToInt64(v)
+	require.True(t, ok)
+	assert.Equal(t, int64(1000), i)
+
+	f, ok := ToFloat64(v)
+	require.True(t, ok)
+	assert.Equal(t, 1000., f)
+}
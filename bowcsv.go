@@ -0,0 +1,219 @@
+package bow
+
+import (
+	"encoding/base64"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/apache/arrow/go/v8/arrow"
+)
+
+const csvDefaultDelimiter = ','
+
+// CSVOptions are options to read and write CSV data:
+// - Delimiter: field delimiter, defaults to ','
+// - NoHeader: sets whether the first record is data instead of the column names
+// - ColNames: column names used when there is no header, defaults to `col_<index>`
+// - ColTypes: column types used when reading, Unknown types being inferred from the values
+// - NullToken: representation of nil values, defaults to the empty string
+type CSVOptions struct {
+	Delimiter rune
+	NoHeader  bool
+	ColNames  []string
+	ColTypes  []Type
+	NullToken string
+}
+
+func (o *CSVOptions) validate() {
+	if o.Delimiter == 0 {
+		o.Delimiter = csvDefaultDelimiter
+	}
+}
+
+// NewBowFromCSV returns a new Bow from the CSV data read from `r`.
+// Values which cannot be converted to their column Type are stored as nil values.
+// Binary values are expected to be encoded as base64 strings, as written by Bow.WriteCSV.
+func NewBowFromCSV(r io.Reader, options CSVOptions) (Bow, error) {
+	options.validate()
+
+	csvReader := csv.NewReader(r)
+	csvReader.Comma = options.Delimiter
+	records, err := csvReader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("csv.Reader.ReadAll: %w", err)
+	}
+
+	var colNames []string
+	switch {
+	case !options.NoHeader:
+		if len(records) == 0 {
+			return nil, fmt.Errorf("missing CSV header")
+		}
+		colNames, records = records[0], records[1:]
+	case options.ColNames != nil:
+		colNames = options.ColNames
+	case len(records) > 0:
+		colNames = make([]string, len(records[0]))
+		for colIndex := range colNames {
+			colNames[colIndex] = fmt.Sprintf("col_%d", colIndex)
+		}
+	}
+
+	if len(records) > 0 && len(records[0]) != len(colNames) {
+		return nil, fmt.Errorf("found %d column names for %d columns", len(colNames), len(records[0]))
+	}
+
+	if options.ColTypes != nil && len(options.ColTypes) != len(colNames) {
+		return nil, fmt.Errorf("found %d column types for %d columns", len(options.ColTypes), len(colNames))
+	}
+
+	series := make([]Series, len(colNames))
+	for colIndex, colName := range colNames {
+		values := make([]interface{}, len(records))
+		for rowIndex, record := range records {
+			if record[colIndex] != options.NullToken {
+				values[rowIndex] = record[colIndex]
+			}
+		}
+
+		typ := Unknown
+		if options.ColTypes != nil {
+			typ = options.ColTypes[colIndex]
+		}
+		if typ == Unknown {
+			typ = getBowTypeFromCSVValues(values)
+		}
+		if !typ.IsSupported() {
+			return nil, fmt.Errorf("unsupported type '%s' for column '%s'", typ, colName)
+		}
+
+		buf := NewBuffer(len(values), typ)
+		for rowIndex, value := range values {
+			if value != nil && typ == Binary {
+				decoded, err := base64.StdEncoding.DecodeString(value.(string))
+				if err != nil {
+					return nil, fmt.Errorf("column '%s': base64.StdEncoding.DecodeString: %w", colName, err)
+				}
+				buf.SetOrDropStrict(rowIndex, decoded)
+				continue
+			}
+			buf.SetOrDrop(rowIndex, value)
+		}
+		series[colIndex] = NewSeriesFromBuffer(colName, buf)
+	}
+
+	return NewBow(series...)
+}
+
+// getBowTypeFromCSVValues infers the Type of a column from its non-nil string values,
+// in the following order of preference: Int64, Float64, Boolean, Date, TimestampNano and String.
+// Defaults to Float64 if there is no value, as getBowTypeFromInterfaces.
+func getBowTypeFromCSVValues(colBasedData []interface{}) Type {
+	candidates := []Type{Int64, Float64, Boolean, Date, TimestampNano}
+	found := false
+	for _, val := range colBasedData {
+		if val == nil {
+			continue
+		}
+		found = true
+		str := val.(string)
+		var remaining []Type
+		for _, typ := range candidates {
+			if isCSVValueOfType(str, typ) {
+				remaining = append(remaining, typ)
+			}
+		}
+		candidates = remaining
+		if len(candidates) == 0 {
+			return String
+		}
+	}
+
+	if !found {
+		return Float64
+	}
+	return candidates[0]
+}
+
+func isCSVValueOfType(str string, typ Type) bool {
+	var err error
+	switch typ {
+	case Int64:
+		_, err = strconv.ParseInt(str, 10, 64)
+	case Float64:
+		_, err = strconv.ParseFloat(str, 64)
+	case Boolean:
+		_, err = strconv.ParseBool(str)
+	case Date:
+		_, err = time.Parse(dateLayout, str)
+	case TimestampNano:
+		_, err = time.Parse(time.RFC3339Nano, str)
+	}
+	return err == nil
+}
+
+// WriteCSV writes the Bow to `w` in the CSV format.
+// Timestamps are written as RFC3339 dates and Binary values as base64 strings.
+// List and Struct columns are not supported.
+func (b *bow) WriteCSV(w io.Writer, options CSVOptions) error {
+	options.validate()
+
+	for colIndex := 0; colIndex < b.NumCols(); colIndex++ {
+		switch b.ColumnType(colIndex) {
+		case List, Struct:
+			return fmt.Errorf("unsupported type '%s' for column '%s'",
+				b.ColumnType(colIndex), b.ColumnName(colIndex))
+		}
+	}
+
+	csvWriter := csv.NewWriter(w)
+	csvWriter.Comma = options.Delimiter
+
+	if !options.NoHeader {
+		header := make([]string, b.NumCols())
+		for colIndex := range header {
+			header[colIndex] = b.ColumnName(colIndex)
+		}
+		if err := csvWriter.Write(header); err != nil {
+			return fmt.Errorf("csv.Writer.Write: %w", err)
+		}
+	}
+
+	record := make([]string, b.NumCols())
+	for rowIndex := 0; rowIndex < b.NumRows(); rowIndex++ {
+		for colIndex := range record {
+			record[colIndex] = b.formatCSVValue(colIndex, rowIndex, options.NullToken)
+		}
+		if err := csvWriter.Write(record); err != nil {
+			return fmt.Errorf("csv.Writer.Write: %w", err)
+		}
+	}
+
+	csvWriter.Flush()
+	if err := csvWriter.Error(); err != nil {
+		return fmt.Errorf("csv.Writer.Flush: %w", err)
+	}
+
+	return nil
+}
+
+func (b *bow) formatCSVValue(colIndex, rowIndex int, nullToken string) string {
+	switch v := b.GetValue(colIndex, rowIndex).(type) {
+	case nil:
+		return nullToken
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case arrow.Timestamp:
+		return timestampToTime(v, b.ColumnType(colIndex).TimeUnit()).Format(time.RFC3339Nano)
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v)
+	default:
+		str, _ := ToString(v)
+		return str
+	}
+}
@@ -0,0 +1,91 @@
+package bow
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSV(t *testing.T) {
+	t.Run("read with inferred types", func(t *testing.T) {
+		input := "int,float,bool,string,date,time,empty\n" +
+			"1,1.5,true,a,2023-01-31,2023-01-31T10:00:00Z,\n" +
+			",2,false,1,,2023-01-31T10:00:00.5Z,\n" +
+			"3,,,,2023-02-01,,\n"
+
+		res, err := NewBowFromCSV(strings.NewReader(input), CSVOptions{})
+		require.NoError(t, err)
+
+		expect, err := NewBowFromRowBasedInterfaces(
+			[]string{"int", "float", "bool", "string", "date", "time", "empty"},
+			[]Type{Int64, Float64, Boolean, String, Date, TimestampNano, Float64},
+			[][]interface{}{
+				{1, 1.5, true, "a", "2023-01-31", "2023-01-31T10:00:00Z", nil},
+				{nil, 2., false, "1", nil, "2023-01-31T10:00:00.5Z", nil},
+				{3, nil, nil, nil, "2023-02-01", nil, nil},
+			})
+		require.NoError(t, err)
+		ExpectEqual(t, expect, res)
+	})
+
+	t.Run("read with options", func(t *testing.T) {
+		input := "1;NA;0.10\n" +
+			"NA;b;0.20\n"
+
+		res, err := NewBowFromCSV(strings.NewReader(input), CSVOptions{
+			Delimiter: ';',
+			NoHeader:  true,
+			ColTypes:  []Type{Int32, Unknown, Decimal},
+			NullToken: "NA",
+		})
+		require.NoError(t, err)
+
+		expect, err := NewBowFromRowBasedInterfaces(
+			[]string{"col_0", "col_1", "col_2"},
+			[]Type{Int32, String, Decimal},
+			[][]interface{}{
+				{1, nil, "0.1"},
+				{nil, "b", "0.2"},
+			})
+		require.NoError(t, err)
+		ExpectEqual(t, expect, res)
+
+		_, err = NewBowFromCSV(strings.NewReader(input), CSVOptions{
+			Delimiter: ';',
+			NoHeader:  true,
+			ColNames:  []string{"a", "b"},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("write and read back", func(t *testing.T) {
+		b, err := NewBowFromRowBasedInterfaces(
+			[]string{"time", "value", "name", "hash"},
+			[]Type{TimestampMilli, Float64, String, Binary},
+			[][]interface{}{
+				{1000, 0.123456789, "a,b", []byte{0x00, 0xff}},
+				{nil, nil, nil, nil},
+			})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, b.WriteCSV(&buf, CSVOptions{NullToken: "null"}))
+		assert.Equal(t, "time,value,name,hash\n"+
+			"1970-01-01T00:00:01Z,0.123456789,\"a,b\",AP8=\n"+
+			"null,null,null,null\n", buf.String())
+
+		res, err := NewBowFromCSV(&buf, CSVOptions{
+			ColTypes:  []Type{TimestampMilli, Unknown, Unknown, Binary},
+			NullToken: "null",
+		})
+		require.NoError(t, err)
+		ExpectEqual(t, b, res)
+
+		nested, err := NewBowFromRowBasedInterfaces([]string{"list"}, []Type{List}, [][]interface{}{})
+		require.NoError(t, err)
+		assert.Error(t, nested.WriteCSV(&buf, CSVOptions{}))
+	})
+}
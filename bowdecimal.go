@@ -0,0 +1,124 @@
+package bow
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/decimal128"
+)
+
+// The Decimal type has a single precision and scale.
+// Arrow decimal128 arrays and parquet DECIMAL columns of any other precision and scale are rescaled to them.
+const (
+	// DecimalPrecision is the maximum number of significant digits of the Decimal type.
+	DecimalPrecision = 38
+	// DecimalScale is the number of digits after the decimal point of the Decimal type.
+	DecimalScale = 9
+)
+
+var (
+	decimalScaleMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(DecimalScale), nil)
+	decimalPrecisionBound  = new(big.Int).Exp(big.NewInt(10), big.NewInt(DecimalPrecision), nil)
+)
+
+// Decimal128 is the Go representation of a Decimal value.
+// It holds an unscaled 128 bits integer, with DecimalScale implied digits after the decimal point.
+type Decimal128 decimal128.Num
+
+// NewDecimal128FromBigInt returns a new Decimal128 from an unscaled integer.
+// Return also a false boolean if `unscaled` exceeds DecimalPrecision digits.
+func NewDecimal128FromBigInt(unscaled *big.Int) (Decimal128, bool) {
+	if new(big.Int).Abs(unscaled).Cmp(decimalPrecisionBound) >= 0 {
+		return Decimal128{}, false
+	}
+	return Decimal128(decimal128.FromBigInt(unscaled)), true
+}
+
+// BigInt returns the unscaled integer of the Decimal128.
+func (d Decimal128) BigInt() *big.Int {
+	return decimal128.Num(d).BigInt()
+}
+
+// Sign returns -1, 0 or 1 depending on the sign of the Decimal128.
+func (d Decimal128) Sign() int {
+	return decimal128.Num(d).Sign()
+}
+
+// Cmp compares two Decimal128, returning -1, 0 or 1 if `d` is respectively less than, equal to or greater than `other`.
+func (d Decimal128) Cmp(other Decimal128) int {
+	return d.BigInt().Cmp(other.BigInt())
+}
+
+// Add returns the exact sum of two Decimal128.
+// Return also a false boolean if the sum exceeds DecimalPrecision digits.
+func (d Decimal128) Add(other Decimal128) (Decimal128, bool) {
+	return NewDecimal128FromBigInt(new(big.Int).Add(d.BigInt(), other.BigInt()))
+}
+
+// Rat returns the exact value of the Decimal128 as a big.Rat.
+func (d Decimal128) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.BigInt(), decimalScaleMultiplier)
+}
+
+// String returns the exact decimal representation of the Decimal128, without trailing zeros.
+func (d Decimal128) String() string {
+	str := d.Rat().FloatString(DecimalScale)
+	str = strings.TrimRight(str, "0")
+	return strings.TrimSuffix(str, ".")
+}
+
+// MarshalJSON encodes the Decimal128 as a JSON string, to prevent any loss of precision.
+func (d Decimal128) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf("%q", d.String())), nil
+}
+
+// ratToDecimal128 rounds `rat` half away from zero to DecimalScale digits.
+func ratToDecimal128(rat *big.Rat) (Decimal128, bool) {
+	scaled := new(big.Rat).Mul(rat, new(big.Rat).SetInt(decimalScaleMultiplier))
+	quo, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(scaled.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(int64(rem.Sign())))
+	}
+	return NewDecimal128FromBigInt(quo)
+}
+
+// newDecimal128FromScaledBigInt returns a new Decimal128 from an unscaled integer with `scale` digits
+// after the decimal point, rounded half away from zero if `scale` is greater than DecimalScale.
+// Return also a false boolean if the value exceeds DecimalPrecision digits.
+func newDecimal128FromScaledBigInt(unscaled *big.Int, scale int32) (Decimal128, bool) {
+	if scale <= DecimalScale {
+		multiplier := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(DecimalScale-scale)), nil)
+		return NewDecimal128FromBigInt(new(big.Int).Mul(unscaled, multiplier))
+	}
+	divisor := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)
+	return ratToDecimal128(new(big.Rat).SetFrac(unscaled, divisor))
+}
+
+// rescaleDecimalSeries returns a new Series with the values of the decimal128 Series `s`
+// rescaled from its precision and scale to the ones of the Decimal type.
+// The Series is returned as is if it is not a decimal128 Series or if it already has the Decimal type.
+func rescaleDecimalSeries(s Series) (Series, error) {
+	dataType, ok := s.Array.DataType().(*arrow.Decimal128Type)
+	if !ok || arrow.TypeEqual(dataType, mapBowToArrowTypes[Decimal]) {
+		return s, nil
+	}
+
+	arr := s.Array.(*array.Decimal128)
+	buf := NewBuffer(arr.Len(), Decimal)
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsNull(i) {
+			continue
+		}
+		d, ok := newDecimal128FromScaledBigInt(arr.Value(i).BigInt(), dataType.Scale)
+		if !ok {
+			return Series{}, fmt.Errorf("bow.Series '%s': row %d: value exceeds %d digits with a scale of %d",
+				s.Name, i, DecimalPrecision, DecimalScale)
+		}
+		buf.SetOrDropStrict(i, d)
+	}
+
+	return NewSeriesFromBuffer(s.Name, buf), nil
+}
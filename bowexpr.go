@@ -0,0 +1,572 @@
+package bow
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/bitutil"
+)
+
+// Expr is an expression computing new values from the columns of a Bow, used by Bow.NewSeriesFromExpr.
+// Expressions are evaluated operation by operation on whole typed Buffers, without boxing a value for each row.
+// The result of an operation is nil for the rows where any of its operands is nil.
+// Arithmetic operations follow the numeric type promotion of promoteNumericTypes.
+// Comparisons with a NaN value are false, except with Ne, as with the Go comparison operators.
+type Expr interface {
+	// eval returns the Buffer of the values of the Expr for each row of `b`.
+	eval(b *bow) (Buffer, error)
+}
+
+type colExpr struct {
+	colIndex int
+}
+
+// Col returns an Expr holding the values of the column `colIndex`.
+func Col(colIndex int) Expr {
+	return colExpr{colIndex: colIndex}
+}
+
+func (e colExpr) eval(b *bow) (Buffer, error) {
+	if e.colIndex < 0 || e.colIndex >= b.NumCols() {
+		return Buffer{}, fmt.Errorf("column index %d out of bound", e.colIndex)
+	}
+	return b.NewBufferFromCol(e.colIndex), nil
+}
+
+type litExpr struct {
+	value interface{}
+}
+
+// Lit returns an Expr holding the constant `value` for all the rows, its Type being inferred from its Go type.
+func Lit(value interface{}) Expr {
+	return litExpr{value: value}
+}
+
+func (e litExpr) eval(b *bow) (Buffer, error) {
+	typ, err := getBowTypeFromInterfaces([]interface{}{e.value})
+	if err != nil {
+		return Buffer{}, err
+	}
+	value := typ.Convert(e.value)
+	if e.value != nil && value == nil {
+		return Buffer{}, fmt.Errorf("unsupported literal value '%v'", e.value)
+	}
+
+	buf := NewBuffer(b.NumRows(), typ)
+	for i := 0; i < b.NumRows(); i++ {
+		buf.SetOrDropStrict(i, value)
+	}
+	return buf, nil
+}
+
+type arithmeticOperator int
+
+const (
+	opAdd arithmeticOperator = iota
+	opSub
+	opMul
+	opDiv
+	opPow
+)
+
+func (op arithmeticOperator) String() string {
+	return [...]string{"+", "-", "*", "/", "pow"}[op]
+}
+
+type arithmeticExpr struct {
+	op          arithmeticOperator
+	left, right Expr
+}
+
+// Add returns an Expr adding the values of `left` and `right`.
+func Add(left, right Expr) Expr {
+	return arithmeticExpr{op: opAdd, left: left, right: right}
+}
+
+// Sub returns an Expr subtracting the values of `right` from the values of `left`.
+func Sub(left, right Expr) Expr {
+	return arithmeticExpr{op: opSub, left: left, right: right}
+}
+
+// Mul returns an Expr multiplying the values of `left` and `right`.
+func Mul(left, right Expr) Expr {
+	return arithmeticExpr{op: opMul, left: left, right: right}
+}
+
+// Div returns a Float64 Expr dividing the values of `left` by the values of `right`, nil for a division by zero.
+func Div(left, right Expr) Expr {
+	return arithmeticExpr{op: opDiv, left: left, right: right}
+}
+
+// Pow returns a Float64 Expr raising the values of `left` to the power of the values of `right`, nil if not a number.
+func Pow(left, right Expr) Expr {
+	return arithmeticExpr{op: opPow, left: left, right: right}
+}
+
+func (e arithmeticExpr) eval(b *bow) (Buffer, error) {
+	left, right, err := evalOperands(b, e.left, e.right)
+	if err != nil {
+		return Buffer{}, err
+	}
+
+	typ, err := promoteNumericTypes(left.DataType, right.DataType)
+	if err != nil {
+		return Buffer{}, fmt.Errorf("operator '%s': %w", e.op, err)
+	}
+	if e.op == opDiv || e.op == opPow {
+		typ = Float64
+	}
+
+	res := Buffer{DataType: typ, nullBitmapBytes: andNullBitmaps(left, right)}
+	switch typ {
+	case Int64:
+		l, r := left.int64Values(), right.int64Values()
+		data := make([]int64, len(l))
+		for i := range data {
+			switch e.op {
+			case opAdd:
+				data[i] = l[i] + r[i]
+			case opSub:
+				data[i] = l[i] - r[i]
+			case opMul:
+				data[i] = l[i] * r[i]
+			}
+		}
+		res.Data = data
+	case Uint64:
+		l, r := left.uint64Values(), right.uint64Values()
+		data := make([]uint64, len(l))
+		for i := range data {
+			switch e.op {
+			case opAdd:
+				data[i] = l[i] + r[i]
+			case opSub:
+				data[i] = l[i] - r[i]
+			case opMul:
+				data[i] = l[i] * r[i]
+			}
+		}
+		res.Data = data
+	default:
+		l, r := left.float64Values(), right.float64Values()
+		data := make([]float64, len(l))
+		for i := range data {
+			switch e.op {
+			case opAdd:
+				data[i] = l[i] + r[i]
+			case opSub:
+				data[i] = l[i] - r[i]
+			case opMul:
+				data[i] = l[i] * r[i]
+			case opDiv:
+				data[i] = l[i] / r[i]
+			case opPow:
+				data[i] = math.Pow(l[i], r[i])
+			}
+		}
+		res.Data = data
+		if e.op == opDiv || e.op == opPow {
+			dropNonFiniteValues(res)
+		}
+	}
+
+	return res, nil
+}
+
+type compareExpr struct {
+	op          compareOperator
+	left, right Expr
+}
+
+// Eq returns a Boolean Expr comparing whether the values of `left` and `right` are equal.
+func Eq(left, right Expr) Expr {
+	return compareExpr{op: opEq, left: left, right: right}
+}
+
+// Ne returns a Boolean Expr comparing whether the values of `left` and `right` are different.
+func Ne(left, right Expr) Expr {
+	return compareExpr{op: opNe, left: left, right: right}
+}
+
+// Lt returns a Boolean Expr comparing whether the values of `left` are lower than the values of `right`.
+func Lt(left, right Expr) Expr {
+	return compareExpr{op: opLt, left: left, right: right}
+}
+
+// Le returns a Boolean Expr comparing whether the values of `left` are lower than or equal to the values of `right`.
+func Le(left, right Expr) Expr {
+	return compareExpr{op: opLe, left: left, right: right}
+}
+
+// Gt returns a Boolean Expr comparing whether the values of `left` are greater than the values of `right`.
+func Gt(left, right Expr) Expr {
+	return compareExpr{op: opGt, left: left, right: right}
+}
+
+// Ge returns a Boolean Expr comparing whether the values of `left` are greater than or equal to the values of `right`.
+func Ge(left, right Expr) Expr {
+	return compareExpr{op: opGe, left: left, right: right}
+}
+
+func (e compareExpr) eval(b *bow) (Buffer, error) {
+	left, right, err := evalOperands(b, e.left, e.right)
+	if err != nil {
+		return Buffer{}, err
+	}
+
+	data := make([]bool, left.Len())
+	lt, rt := left.DataType, right.DataType
+	switch {
+	case lt.isInteger() && rt.isInteger() && lt.isUnsigned() != rt.isUnsigned():
+		// signed and unsigned integers are compared exactly instead of as promoted Float64 values
+		if lt.isUnsigned() {
+			l, r := left.uint64Values(), right.int64Values()
+			for i := range data {
+				data[i] = e.op.match(-compareInt64Uint64(r[i], l[i]))
+			}
+		} else {
+			l, r := left.int64Values(), right.uint64Values()
+			for i := range data {
+				data[i] = e.op.match(compareInt64Uint64(l[i], r[i]))
+			}
+		}
+	case lt.isNumeric() && rt.isNumeric():
+		switch typ, _ := promoteNumericTypes(lt, rt); typ {
+		case Int64:
+			l, r := left.int64Values(), right.int64Values()
+			for i := range data {
+				data[i] = e.op.match(compareInt64(l[i], r[i]))
+			}
+		case Uint64:
+			l, r := left.uint64Values(), right.uint64Values()
+			for i := range data {
+				data[i] = e.op.match(compareUint64(l[i], r[i]))
+			}
+		default:
+			l, r := left.float64Values(), right.float64Values()
+			for i := range data {
+				data[i] = e.op.match(compareFloat64(l[i], r[i]))
+			}
+		}
+	case (lt == String || lt == Dictionary) && (rt == String || rt == Dictionary):
+		l, r := left.Data.([]string), right.Data.([]string)
+		for i := range data {
+			data[i] = e.op.match(compareString(l[i], r[i]))
+		}
+	case lt == rt && (lt == Boolean || lt.IsTimestamp() || lt == Date || lt == Duration):
+		l, r := left.int64Values(), right.int64Values()
+		for i := range data {
+			data[i] = e.op.match(compareInt64(l[i], r[i]))
+		}
+	default:
+		return Buffer{}, fmt.Errorf("operator '%s': types '%s' and '%s' are not comparable", e.op, lt, rt)
+	}
+
+	return Buffer{Data: data, DataType: Boolean, nullBitmapBytes: andNullBitmaps(left, right)}, nil
+}
+
+type logicalExpr struct {
+	isOr        bool
+	left, right Expr
+}
+
+// And returns a Boolean Expr true where the Boolean values of `left` and `right` are both true.
+func And(left, right Expr) Expr {
+	return logicalExpr{left: left, right: right}
+}
+
+// Or returns a Boolean Expr true where at least one of the Boolean values of `left` and `right` is true.
+func Or(left, right Expr) Expr {
+	return logicalExpr{isOr: true, left: left, right: right}
+}
+
+func (e logicalExpr) eval(b *bow) (Buffer, error) {
+	left, right, err := evalOperands(b, e.left, e.right)
+	if err != nil {
+		return Buffer{}, err
+	}
+	if left.DataType != Boolean || right.DataType != Boolean {
+		return Buffer{}, fmt.Errorf("types '%s' and '%s' are not both boolean", left.DataType, right.DataType)
+	}
+
+	l, r := left.Data.([]bool), right.Data.([]bool)
+	data := make([]bool, len(l))
+	for i := range data {
+		if e.isOr {
+			data[i] = l[i] || r[i]
+		} else {
+			data[i] = l[i] && r[i]
+		}
+	}
+
+	return Buffer{Data: data, DataType: Boolean, nullBitmapBytes: andNullBitmaps(left, right)}, nil
+}
+
+type unaryExpr struct {
+	name    string
+	operand Expr
+	// fn computes the result from a numeric Buffer
+	fn func(buf Buffer) Buffer
+}
+
+// Not returns a Boolean Expr negating the Boolean values of `e`.
+func Not(e Expr) Expr {
+	return unaryExpr{name: "not", operand: e}
+}
+
+// Abs returns an Expr with the absolute values of `e`, of Type Int64, Uint64 or Float64 following promoteNumericTypes.
+// The absolute value of the minimum Int64 wraps around to itself.
+func Abs(e Expr) Expr {
+	return unaryExpr{name: "abs", operand: e, fn: func(buf Buffer) Buffer {
+		switch typ, _ := promoteNumericTypes(buf.DataType, buf.DataType); typ {
+		case Int64:
+			data := buf.int64Values()
+			for i, v := range data {
+				if v < 0 {
+					data[i] = -v
+				}
+			}
+			return Buffer{Data: data, DataType: Int64, nullBitmapBytes: buf.nullBitmapBytes}
+		case Uint64:
+			return Buffer{Data: buf.uint64Values(), DataType: Uint64, nullBitmapBytes: buf.nullBitmapBytes}
+		default:
+			return mapFloat64Buffer(buf, math.Abs)
+		}
+	}}
+}
+
+// Round returns a Float64 Expr with the values of `e` rounded half away from zero to `digits` decimal places.
+func Round(e Expr, digits int) Expr {
+	scale := math.Pow10(digits)
+	return unaryExpr{name: "round", operand: e, fn: func(buf Buffer) Buffer {
+		return mapFloat64Buffer(buf, func(v float64) float64 {
+			return math.Round(v*scale) / scale
+		})
+	}}
+}
+
+// Sqrt returns a Float64 Expr with the square roots of the values of `e`, nil for the negative values.
+func Sqrt(e Expr) Expr {
+	return unaryExpr{name: "sqrt", operand: e, fn: func(buf Buffer) Buffer {
+		return mapFloat64Buffer(buf, math.Sqrt)
+	}}
+}
+
+// Exp returns a Float64 Expr with the base-e exponentials of the values of `e`.
+func Exp(e Expr) Expr {
+	return unaryExpr{name: "exp", operand: e, fn: func(buf Buffer) Buffer {
+		return mapFloat64Buffer(buf, math.Exp)
+	}}
+}
+
+// Log returns a Float64 Expr with the natural logarithms of the values of `e`, nil for the values lower than or equal to 0.
+func Log(e Expr) Expr {
+	return unaryExpr{name: "log", operand: e, fn: func(buf Buffer) Buffer {
+		return mapFloat64Buffer(buf, math.Log)
+	}}
+}
+
+func (e unaryExpr) eval(b *bow) (Buffer, error) {
+	if e.operand == nil {
+		return Buffer{}, fmt.Errorf("%s: nil expression", e.name)
+	}
+	buf, err := e.operand.eval(b)
+	if err != nil {
+		return Buffer{}, err
+	}
+
+	if e.fn == nil {
+		if buf.DataType != Boolean {
+			return Buffer{}, fmt.Errorf("%s: type '%s' is not boolean", e.name, buf.DataType)
+		}
+		data := buf.Data.([]bool)
+		for i := range data {
+			data[i] = !data[i]
+		}
+		return buf, nil
+	}
+
+	if !buf.DataType.isNumeric() {
+		return Buffer{}, fmt.Errorf("%s: type '%s' is not numeric", e.name, buf.DataType)
+	}
+	return e.fn(buf), nil
+}
+
+// NewSeriesFromExpr returns a new Series named `name` with the values of the Expr `expr` evaluated on the Bow,
+// which can be added to it with AddCols.
+func (b *bow) NewSeriesFromExpr(name string, expr Expr) (Series, error) {
+	if expr == nil {
+		return Series{}, fmt.Errorf("nil expression")
+	}
+
+	buf, err := expr.eval(b)
+	if err != nil {
+		return Series{}, err
+	}
+
+	return NewSeriesFromBuffer(name, buf), nil
+}
+
+func evalOperands(b *bow, left, right Expr) (Buffer, Buffer, error) {
+	if left == nil || right == nil {
+		return Buffer{}, Buffer{}, fmt.Errorf("nil expression")
+	}
+	l, err := left.eval(b)
+	if err != nil {
+		return Buffer{}, Buffer{}, err
+	}
+	r, err := right.eval(b)
+	if err != nil {
+		return Buffer{}, Buffer{}, err
+	}
+	return l, r, nil
+}
+
+// andNullBitmaps returns a new null bitmap with the rows valid in both Buffers.
+func andNullBitmaps(left, right Buffer) []byte {
+	res := make([]byte, len(left.nullBitmapBytes))
+	for i := range res {
+		res[i] = left.nullBitmapBytes[i] & right.nullBitmapBytes[i]
+	}
+	return res
+}
+
+// mapFloat64Buffer returns a new Float64 Buffer with the results of `fn` on the values of the numeric `buf`,
+// the results which are not finite numbers being nil.
+func mapFloat64Buffer(buf Buffer, fn func(float64) float64) Buffer {
+	data := buf.float64Values()
+	for i, v := range data {
+		data[i] = fn(v)
+	}
+	res := Buffer{Data: data, DataType: Float64, nullBitmapBytes: buf.nullBitmapBytes}
+	dropNonFiniteValues(res)
+	return res
+}
+
+func dropNonFiniteValues(buf Buffer) {
+	for i, v := range buf.Data.([]float64) {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			bitutil.ClearBit(buf.nullBitmapBytes, i)
+		}
+	}
+}
+
+func compareUint64(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareInt64Uint64(a int64, b uint64) int {
+	if a < 0 {
+		return -1
+	}
+	return compareUint64(uint64(a), b)
+}
+
+// int64Values returns the values of a Buffer of an integer, Boolean, timestamp, Date or Duration Type as int64.
+func (b Buffer) int64Values() []int64 {
+	res := make([]int64, b.Len())
+	switch data := b.Data.(type) {
+	case []int64:
+		copy(res, data)
+	case []int8:
+		for i, v := range data {
+			res[i] = int64(v)
+		}
+	case []int16:
+		for i, v := range data {
+			res[i] = int64(v)
+		}
+	case []int32:
+		for i, v := range data {
+			res[i] = int64(v)
+		}
+	case []uint8:
+		for i, v := range data {
+			res[i] = int64(v)
+		}
+	case []uint16:
+		for i, v := range data {
+			res[i] = int64(v)
+		}
+	case []uint32:
+		for i, v := range data {
+			res[i] = int64(v)
+		}
+	case []uint64:
+		for i, v := range data {
+			res[i] = int64(v)
+		}
+	case []bool:
+		for i, v := range data {
+			res[i] = boolToInt64(v)
+		}
+	case []arrow.Timestamp:
+		for i, v := range data {
+			res[i] = int64(v)
+		}
+	case []arrow.Date32:
+		for i, v := range data {
+			res[i] = int64(v)
+		}
+	case []arrow.Duration:
+		for i, v := range data {
+			res[i] = int64(v)
+		}
+	default:
+		panic(fmt.Errorf("type '%s' is not convertible to int64", b.DataType))
+	}
+	return res
+}
+
+// uint64Values returns the values of a Buffer of an unsigned integer Type as uint64.
+func (b Buffer) uint64Values() []uint64 {
+	res := make([]uint64, b.Len())
+	switch data := b.Data.(type) {
+	case []uint64:
+		copy(res, data)
+	case []uint8:
+		for i, v := range data {
+			res[i] = uint64(v)
+		}
+	case []uint16:
+		for i, v := range data {
+			res[i] = uint64(v)
+		}
+	case []uint32:
+		for i, v := range data {
+			res[i] = uint64(v)
+		}
+	default:
+		panic(fmt.Errorf("type '%s' is not convertible to uint64", b.DataType))
+	}
+	return res
+}
+
+// float64Values returns the values of a Buffer of a numeric Type as float64.
+func (b Buffer) float64Values() []float64 {
+	res := make([]float64, b.Len())
+	switch data := b.Data.(type) {
+	case []float64:
+		copy(res, data)
+	case []float32:
+		for i, v := range data {
+			res[i] = float64(v)
+		}
+	case []uint64:
+		for i, v := range data {
+			res[i] = float64(v)
+		}
+	default:
+		for i, v := range b.int64Values() {
+			res[i] = float64(v)
+		}
+	}
+	return res
+}
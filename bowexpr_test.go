@@ -0,0 +1,153 @@
+package bow
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBow_NewSeriesFromExpr(t *testing.T) {
+	b, err := NewBowFromRowBasedInterfaces(
+		[]string{"int", "float", "uint", "bool", "string", "time"},
+		[]Type{Int64, Float64, Uint64, Boolean, String, TimestampMilli},
+		[][]interface{}{
+			{1, 1.5, 2, true, "a", 10},
+			{-2, nil, 3, false, "b", 20},
+			{nil, 3.5, nil, nil, nil, nil},
+			{4, 0., 5, true, "d", 40},
+		})
+	require.NoError(t, err)
+
+	for _, testCase := range []struct {
+		name     string
+		expr     Expr
+		typ      Type
+		expected []interface{}
+	}{
+		{name: "col", expr: Col(0), typ: Int64, expected: []interface{}{1, -2, nil, 4}},
+		{name: "lit", expr: Lit(2), typ: Int64, expected: []interface{}{2, 2, 2, 2}},
+		{name: "add int", expr: Add(Col(0), Lit(1)), typ: Int64, expected: []interface{}{2, -1, nil, 5}},
+		{name: "add int and float", expr: Add(Col(0), Col(1)), typ: Float64, expected: []interface{}{2.5, nil, nil, 4.}},
+		{name: "sub uint", expr: Sub(Col(2), Col(2)), typ: Uint64, expected: []interface{}{0, 0, nil, 0}},
+		{name: "mul int and uint", expr: Mul(Col(0), Col(2)), typ: Float64, expected: []interface{}{2., -6., nil, 20.}},
+		{name: "add max uint and int", expr: Add(Lit(uint64(math.MaxUint64)), Lit(1)), typ: Float64,
+			expected: []interface{}{float64(math.MaxUint64) + 1, float64(math.MaxUint64) + 1, float64(math.MaxUint64) + 1, float64(math.MaxUint64) + 1}},
+		{name: "add int wraps", expr: Add(Lit(int64(math.MaxInt64)), Lit(1)), typ: Int64,
+			expected: []interface{}{math.MinInt64, math.MinInt64, math.MinInt64, math.MinInt64}},
+		{name: "div", expr: Div(Col(0), Col(1)), typ: Float64, expected: []interface{}{1 / 1.5, nil, nil, nil}},
+		{name: "pow", expr: Pow(Col(0), Lit(2)), typ: Float64, expected: []interface{}{1., 4., nil, 16.}},
+		{name: "eq", expr: Eq(Col(0), Lit(4)), typ: Boolean, expected: []interface{}{false, false, nil, true}},
+		{name: "lt int and float", expr: Lt(Col(0), Col(1)), typ: Boolean, expected: []interface{}{true, nil, nil, false}},
+		{name: "ge uint", expr: Ge(Col(2), Lit(3)), typ: Boolean, expected: []interface{}{false, true, nil, true}},
+		{name: "lt int and uint", expr: Lt(Col(0), Col(2)), typ: Boolean, expected: []interface{}{true, true, nil, true}},
+		{name: "gt max uint and max int", expr: Gt(Lit(uint64(math.MaxUint64)), Lit(int64(math.MaxInt64))),
+			typ: Boolean, expected: []interface{}{true, true, true, true}},
+		{name: "eq max uint and negative int", expr: Eq(Lit(uint64(math.MaxUint64)), Lit(-1)), typ: Boolean,
+			expected: []interface{}{false, false, false, false}},
+		{name: "ge uint and negative int", expr: Ge(Col(2), Lit(-1)), typ: Boolean, expected: []interface{}{true, true, nil, true}},
+		{name: "ne string", expr: Ne(Col(4), Lit("b")), typ: Boolean, expected: []interface{}{true, false, nil, true}},
+		{name: "gt timestamp", expr: Gt(Col(5), Col(5)), typ: Boolean, expected: []interface{}{false, false, nil, false}},
+		{name: "le bool", expr: Le(Col(3), Lit(false)), typ: Boolean, expected: []interface{}{false, true, nil, false}},
+		{name: "and", expr: And(Col(3), Gt(Col(0), Lit(0))), typ: Boolean, expected: []interface{}{true, false, nil, true}},
+		{name: "or", expr: Or(Col(3), Lt(Col(0), Lit(0))), typ: Boolean, expected: []interface{}{true, true, nil, true}},
+		{name: "not", expr: Not(Col(3)), typ: Boolean, expected: []interface{}{false, true, nil, false}},
+		{name: "abs int", expr: Abs(Col(0)), typ: Int64, expected: []interface{}{1, 2, nil, 4}},
+		{name: "abs float", expr: Abs(Sub(Lit(1.), Col(1))), typ: Float64, expected: []interface{}{0.5, nil, 2.5, 1.}},
+		{name: "round", expr: Round(Div(Col(1), Lit(3)), 2), typ: Float64, expected: []interface{}{0.5, nil, 1.17, 0.}},
+		{name: "sqrt", expr: Sqrt(Col(0)), typ: Float64, expected: []interface{}{1., nil, nil, 2.}},
+		{name: "exp", expr: Exp(Col(1)), typ: Float64, expected: []interface{}{math.Exp(1.5), nil, math.Exp(3.5), 1.}},
+		{name: "log", expr: Log(Col(1)), typ: Float64, expected: []interface{}{math.Log(1.5), nil, math.Log(3.5), nil}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			series, err := b.NewSeriesFromExpr("res", testCase.expr)
+			require.NoError(t, err)
+
+			expected, err := NewBowFromColBasedInterfaces([]string{"res"}, []Type{testCase.typ},
+				[][]interface{}{testCase.expected})
+			require.NoError(t, err)
+			res, err := NewBow(series)
+			require.NoError(t, err)
+			ExpectEqual(t, expected, res)
+		})
+	}
+
+	t.Run("comparisons with NaN", func(t *testing.T) {
+		nan, err := NewBowFromColBasedInterfaces([]string{"float"}, []Type{Float64},
+			[][]interface{}{{5., math.NaN()}})
+		require.NoError(t, err)
+
+		for _, testCase := range []struct {
+			name     string
+			expr     Expr
+			expected []interface{}
+		}{
+			{name: "eq", expr: Eq(Col(0), Lit(5.)), expected: []interface{}{true, false}},
+			{name: "ne", expr: Ne(Col(0), Lit(5.)), expected: []interface{}{false, true}},
+			{name: "le", expr: Le(Col(0), Lit(5.)), expected: []interface{}{true, false}},
+			{name: "ge", expr: Ge(Col(0), Lit(5.)), expected: []interface{}{true, false}},
+			{name: "eq to itself", expr: Eq(Col(0), Col(0)), expected: []interface{}{true, false}},
+		} {
+			t.Run(testCase.name, func(t *testing.T) {
+				series, err := nan.NewSeriesFromExpr("res", testCase.expr)
+				require.NoError(t, err)
+
+				expected, err := NewBowFromColBasedInterfaces([]string{"res"}, []Type{Boolean},
+					[][]interface{}{testCase.expected})
+				require.NoError(t, err)
+				res, err := NewBow(series)
+				require.NoError(t, err)
+				ExpectEqual(t, expected, res)
+			})
+		}
+	})
+
+	t.Run("with AddCols", func(t *testing.T) {
+		series, err := b.NewSeriesFromExpr("sum", Add(Col(0), Mul(Col(0), Lit(2))))
+		require.NoError(t, err)
+		res, err := b.AddCols(series)
+		require.NoError(t, err)
+
+		assert.Equal(t, 7, res.NumCols())
+		assert.Equal(t, Int64, res.ColumnType(6))
+		assert.Equal(t, []interface{}{int64(3), int64(-6), nil, int64(12)},
+			[]interface{}{res.GetValue(6, 0), res.GetValue(6, 1), res.GetValue(6, 2), res.GetValue(6, 3)})
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for name, expr := range map[string]Expr{
+			"nil":                 nil,
+			"nil operand":         Add(Col(0), nil),
+			"out of bound":        Col(6),
+			"unsupported literal": Lit(make(chan int)),
+			"add string":          Add(Col(4), Lit(1)),
+			"compare string":      Lt(Col(4), Lit(1)),
+			"compare timestamp":   Lt(Col(5), Lit(1)),
+			"and int":             And(Col(0), Col(3)),
+			"not float":           Not(Col(1)),
+			"abs string":          Abs(Col(4)),
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := b.NewSeriesFromExpr("res", expr)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func BenchmarkBow_NewSeriesFromExpr(b *testing.B) {
+	for rows := 10; rows <= 100000; rows *= 10 {
+		data, err := NewBowFromParquet(fmt.Sprintf(
+			"%sbow1-%d-rows.parquet", benchmarkBowsDirPath, rows), false)
+		require.NoError(b, err)
+
+		b.Run(fmt.Sprintf("%d_rows", rows), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, err = data.NewSeriesFromExpr("res", Mul(Add(Col(2), Col(3)), Lit(2)))
+				require.NoError(b, err)
+			}
+		})
+	}
+}
@@ -239,8 +239,27 @@ func fill(method string, b *bow, colIndices ...int) (Bow, error) {
 						buf.SetOrDropStrict(rowIndex, arr.Value(fillRowIndex))
 					}
 				}
+			case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+				arr := array.NewTimestampData(data)
+				for rowIndex := 0; rowIndex < b.NumRows(); rowIndex++ {
+					if buf.IsValid(rowIndex) {
+						continue
+					}
+					fillRowIndex := getFillRowIndex(b, method, colIndex, rowIndex)
+					if fillRowIndex > -1 {
+						buf.SetOrDropStrict(rowIndex, arr.Value(fillRowIndex))
+					}
+				}
 			default:
-				filledSeries[colIndex] = b.NewSeriesFromCol(colIndex)
+				for rowIndex := 0; rowIndex < b.NumRows(); rowIndex++ {
+					if buf.IsValid(rowIndex) {
+						continue
+					}
+					fillRowIndex := getFillRowIndex(b, method, colIndex, rowIndex)
+					if fillRowIndex > -1 {
+						buf.SetOrDropStrict(rowIndex, buf.GetValue(fillRowIndex))
+					}
+				}
 			}
 
 			filledSeries[colIndex] = NewSeriesFromBuffer(colName, buf)
@@ -1,5 +1,11 @@
 package bow
 
+import (
+	"reflect"
+
+	"github.com/apache/arrow/go/v8/arrow/array"
+)
+
 // Find returns the index of the row where `value` is found in the `colIndex` column.
 // Returns -1 if the value is not found.
 func (b *bow) Find(colIndex int, value interface{}) int {
@@ -18,14 +24,44 @@ func (b *bow) FindNext(colIndex, rowIndex int, value interface{}) int {
 		return -1
 	}
 
+	if b.ColumnType(colIndex) == Dictionary {
+		return b.findNextDictionaryCode(colIndex, rowIndex, value)
+	}
+
 	for i := rowIndex; i < b.NumRows(); i++ {
-		if value == b.GetValue(colIndex, i) {
+		if isEqual(value, b.GetValue(colIndex, i)) {
 			return i
 		}
 	}
 	return -1
 }
 
+// findNextDictionaryCode looks up the dictionary code of `value` once, then scans the column codes only.
+func (b *bow) findNextDictionaryCode(colIndex, rowIndex int, value interface{}) int {
+	arr := b.Column(colIndex).(*array.Dictionary)
+	codes := dictionaryCodes(arr, value)
+	if len(codes) == 0 {
+		return -1
+	}
+
+	for i := rowIndex; i < arr.Len(); i++ {
+		if arr.IsValid(i) {
+			if _, ok := codes[arr.GetValueIndex(i)]; ok {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// isEqual compares two values, which can be of non-comparable types such as the List and Struct values.
+func isEqual(a, b interface{}) bool {
+	if a != nil && !reflect.TypeOf(a).Comparable() {
+		return reflect.DeepEqual(a, b)
+	}
+	return a == b
+}
+
 // Contains returns whether `value` is found in `colIndex` columns.
 func (b *bow) Contains(colIndex int, value interface{}) bool {
 	return b.Find(colIndex, value) != -1
@@ -19,6 +19,9 @@ var sortedTestBow, _ = NewBow(
 	NewSeries(Boolean.String(), Boolean,
 		[]bool{false, true, false, false},
 		[]bool{true, true, false, true}),
+	NewSeries(Dictionary.String(), Dictionary,
+		[]string{"0", "1", "0", "0"},
+		[]bool{true, true, false, true}),
 )
 
 func TestBow_Find(t *testing.T) {
@@ -4,7 +4,9 @@ import (
 	crand "crypto/rand"
 	"fmt"
 	"math/big"
+	"time"
 
+	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/google/uuid"
 )
 
@@ -130,6 +132,26 @@ func newRandomNumber(typ Type) interface{} {
 		return n.Int64() > 5
 	case String:
 		return uuid.New().String()[:8]
+	case Dictionary:
+		return fmt.Sprintf("category_%d", n.Int64())
+	case Decimal:
+		return typ.Convert(n.Int64())
+	case List:
+		return []interface{}{n.Int64(), n.Int64() + 1}
+	case Struct:
+		return map[string]interface{}{"id": n.Int64(), "value": float64(n.Int64()) + 0.5}
+	case Binary:
+		return []byte(uuid.New().String()[:8])
+	case Date:
+		return arrow.Date32(n.Int64())
+	case Duration:
+		return arrow.Duration(n.Int64() * int64(time.Second))
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		return arrow.Timestamp(n.Int64())
+	case Int8, Int16, Int32, Uint8, Uint16, Uint32, Uint64:
+		return typ.Convert(n.Int64())
+	case Float32:
+		return float32(n.Int64()) + 0.5
 	default:
 		panic("unsupported data type")
 	}
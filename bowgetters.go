@@ -57,6 +57,39 @@ func (b *bow) GetValue(colIndex, rowIndex int) interface{} {
 		return array.NewBooleanData(b.Column(colIndex).Data()).Value(rowIndex)
 	case String:
 		return array.NewStringData(b.Column(colIndex).Data()).Value(rowIndex)
+	case Dictionary:
+		arr := array.NewDictionaryData(b.Column(colIndex).Data())
+		return arr.Dictionary().(*array.String).Value(arr.GetValueIndex(rowIndex))
+	case Decimal:
+		return Decimal128(array.NewDecimal128Data(b.Column(colIndex).Data()).Value(rowIndex))
+	case List:
+		return listValue(array.NewListData(b.Column(colIndex).Data()), rowIndex)
+	case Struct:
+		return structValue(array.NewStructData(b.Column(colIndex).Data()), rowIndex)
+	case Binary:
+		return append([]byte{}, array.NewBinaryData(b.Column(colIndex).Data()).Value(rowIndex)...)
+	case Date:
+		return array.NewDate32Data(b.Column(colIndex).Data()).Value(rowIndex)
+	case Duration:
+		return array.NewDurationData(b.Column(colIndex).Data()).Value(rowIndex)
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		return array.NewTimestampData(b.Column(colIndex).Data()).Value(rowIndex)
+	case Int8:
+		return array.NewInt8Data(b.Column(colIndex).Data()).Value(rowIndex)
+	case Int16:
+		return array.NewInt16Data(b.Column(colIndex).Data()).Value(rowIndex)
+	case Int32:
+		return array.NewInt32Data(b.Column(colIndex).Data()).Value(rowIndex)
+	case Uint8:
+		return array.NewUint8Data(b.Column(colIndex).Data()).Value(rowIndex)
+	case Uint16:
+		return array.NewUint16Data(b.Column(colIndex).Data()).Value(rowIndex)
+	case Uint32:
+		return array.NewUint32Data(b.Column(colIndex).Data()).Value(rowIndex)
+	case Uint64:
+		return array.NewUint64Data(b.Column(colIndex).Data()).Value(rowIndex)
+	case Float32:
+		return array.NewFloat32Data(b.Column(colIndex).Data()).Value(rowIndex)
 	default:
 		panic(fmt.Errorf("unsupported type '%s'", b.ColumnType(colIndex)))
 	}
@@ -161,6 +194,36 @@ func (b *bow) GetInt64(colIndex, rowIndex int) (int64, bool) {
 	case arrow.INT64:
 		vd := array.NewInt64Data(b.Column(colIndex).Data())
 		return vd.Value(rowIndex), vd.IsValid(rowIndex)
+	case arrow.TIMESTAMP:
+		vd := array.NewTimestampData(b.Column(colIndex).Data())
+		return int64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.INT8:
+		vd := array.NewInt8Data(b.Column(colIndex).Data())
+		return int64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.INT16:
+		vd := array.NewInt16Data(b.Column(colIndex).Data())
+		return int64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.INT32:
+		vd := array.NewInt32Data(b.Column(colIndex).Data())
+		return int64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.UINT8:
+		vd := array.NewUint8Data(b.Column(colIndex).Data())
+		return int64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.UINT16:
+		vd := array.NewUint16Data(b.Column(colIndex).Data())
+		return int64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.UINT32:
+		vd := array.NewUint32Data(b.Column(colIndex).Data())
+		return int64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.UINT64:
+		vd := array.NewUint64Data(b.Column(colIndex).Data())
+		if vd.IsValid(rowIndex) {
+			return ToInt64(vd.Value(rowIndex))
+		}
+		return 0, false
+	case arrow.FLOAT32:
+		vd := array.NewFloat32Data(b.Column(colIndex).Data())
+		return int64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
 	case arrow.FLOAT64:
 		vd := array.NewFloat64Data(b.Column(colIndex).Data())
 		return int64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
@@ -177,6 +240,11 @@ func (b *bow) GetInt64(colIndex, rowIndex int) (int64, bool) {
 			return ToInt64(vd.Value(rowIndex))
 		}
 		return 0., false
+	case arrow.DICTIONARY, arrow.DECIMAL128, arrow.DATE32, arrow.DURATION:
+		if value := b.GetValue(colIndex, rowIndex); value != nil {
+			return ToInt64(value)
+		}
+		return 0, false
 	default:
 		panic(fmt.Errorf("unsupported arrow.DataType '%s'",
 			b.Schema().Field(colIndex).Type))
@@ -227,6 +295,33 @@ func (b *bow) GetFloat64(colIndex, rowIndex int) (float64, bool) {
 	case arrow.INT64:
 		vd := array.NewInt64Data(b.Column(colIndex).Data())
 		return float64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.TIMESTAMP:
+		vd := array.NewTimestampData(b.Column(colIndex).Data())
+		return float64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.INT8:
+		vd := array.NewInt8Data(b.Column(colIndex).Data())
+		return float64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.INT16:
+		vd := array.NewInt16Data(b.Column(colIndex).Data())
+		return float64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.INT32:
+		vd := array.NewInt32Data(b.Column(colIndex).Data())
+		return float64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.UINT8:
+		vd := array.NewUint8Data(b.Column(colIndex).Data())
+		return float64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.UINT16:
+		vd := array.NewUint16Data(b.Column(colIndex).Data())
+		return float64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.UINT32:
+		vd := array.NewUint32Data(b.Column(colIndex).Data())
+		return float64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.UINT64:
+		vd := array.NewUint64Data(b.Column(colIndex).Data())
+		return float64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
+	case arrow.FLOAT32:
+		vd := array.NewFloat32Data(b.Column(colIndex).Data())
+		return float64(vd.Value(rowIndex)), vd.IsValid(rowIndex)
 	case arrow.BOOL:
 		vd := array.NewBooleanData(b.Column(colIndex).Data())
 		booleanValue := vd.Value(rowIndex)
@@ -240,6 +335,11 @@ func (b *bow) GetFloat64(colIndex, rowIndex int) (float64, bool) {
 			return ToFloat64(vd.Value(rowIndex))
 		}
 		return 0., false
+	case arrow.DICTIONARY, arrow.DECIMAL128, arrow.DATE32, arrow.DURATION:
+		if value := b.GetValue(colIndex, rowIndex); value != nil {
+			return ToFloat64(value)
+		}
+		return 0., false
 	default:
 		panic(fmt.Sprintf("unsupported arrow.DataType '%s'",
 			b.Schema().Field(colIndex).Type))
@@ -312,7 +412,7 @@ func (b *bow) GetNextFloat64s(colIndex1, colIndex2, rowIndex int) (float64, floa
 
 // ColumnType returns the Bow type from the column `colIndex`.
 func (b *bow) ColumnType(colIndex int) Type {
-	return getBowTypeFromArrowFingerprint(b.Schema().Field(colIndex).Type.Fingerprint())
+	return getBowTypeFromArrowType(b.Schema().Field(colIndex).Type)
 }
 
 // ColumnIndex returns the index of the column with the name `colName`, and an error.
@@ -332,18 +432,60 @@ func (b *bow) ColumnIndex(colName string) (int, error) {
 
 // Distinct returns all non-nil different values found in the column `colIndex` in a new Bow.
 func (b *bow) Distinct(colIndex int) Bow {
-	hitMap := make(map[interface{}]struct{})
+	if b.ColumnType(colIndex) == Dictionary {
+		return b.distinctDictionary(colIndex)
+	}
+
+	// Nested and Binary values are not comparable, their string representations are used as keys instead
+	isNotComparable := b.ColumnType(colIndex) == List || b.ColumnType(colIndex) == Struct || b.ColumnType(colIndex) == Binary
+	hitMap := make(map[interface{}]interface{})
 	for i := 0; i < b.NumRows(); i++ {
 		val := b.GetValue(colIndex, i)
-		if val != nil {
-			hitMap[val] = struct{}{}
+		if val == nil {
+			continue
+		}
+		if isNotComparable {
+			hitMap[fmt.Sprint(val)] = val
+		} else {
+			hitMap[val] = val
 		}
 	}
 
 	buf := NewBuffer(len(hitMap), b.ColumnType(colIndex))
+	if timestampType, ok := b.Column(colIndex).DataType().(*arrow.TimestampType); ok {
+		buf.timeZone = timestampType.TimeZone
+	}
+	i := 0
+	for _, val := range hitMap {
+		buf.SetOrDropStrict(i, val)
+		i++
+	}
+
+	sort.Sort(buf)
+
+	res, err := NewBow(NewSeriesFromBuffer(b.ColumnName(colIndex), buf))
+	if err != nil {
+		panic(err)
+	}
+
+	return res
+}
+
+// distinctDictionary collects the distinct codes of a Dictionary column before decoding them.
+func (b *bow) distinctDictionary(colIndex int) Bow {
+	arr := b.Column(colIndex).(*array.Dictionary)
+	hitMap := make(map[int]struct{})
+	for i := 0; i < arr.Len(); i++ {
+		if arr.IsValid(i) {
+			hitMap[arr.GetValueIndex(i)] = struct{}{}
+		}
+	}
+
+	dict := arr.Dictionary().(*array.String)
+	buf := NewBuffer(len(hitMap), Dictionary)
 	i := 0
-	for k := range hitMap {
-		buf.SetOrDropStrict(i, k)
+	for code := range hitMap {
+		buf.SetOrDropStrict(i, dict.Value(code))
 		i++
 	}
 
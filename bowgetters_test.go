@@ -66,4 +66,29 @@ func TestBow_Distinct(t *testing.T) {
 
 		ExpectEqual(t, expect, res)
 	})
+
+	t.Run(Dictionary.String(), func(t *testing.T) {
+		b, err := NewBowFromColBasedInterfaces([]string{"meta"}, []Type{Dictionary},
+			[][]interface{}{{"b", "a", nil, "b", "c"}})
+		require.NoError(t, err)
+
+		res := b.Distinct(0)
+		expect, err := NewBow(NewSeries("meta", Dictionary, []string{"a", "b", "c"}, nil))
+		require.NoError(t, err)
+
+		ExpectEqual(t, expect, res)
+	})
+
+	t.Run(Binary.String(), func(t *testing.T) {
+		b, err := NewBowFromColBasedInterfaces([]string{"hash"}, []Type{Binary},
+			[][]interface{}{{[]byte{0x02}, []byte{0x01}, nil, []byte{0x02}, []byte{}}})
+		require.NoError(t, err)
+
+		res := b.Distinct(0)
+		expect, err := NewBow(NewSeries("hash", Binary, [][]byte{{}, {0x01}, {0x02}}, nil))
+		require.NoError(t, err)
+
+		ExpectEqual(t, expect, res)
+		assert.Equal(t, 1, b.Find(0, []byte{0x01}))
+	})
 }
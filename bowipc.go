@@ -0,0 +1,153 @@
+package bow
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/ipc"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+)
+
+// IPCFormat is a serialization format of the Arrow IPC protocol.
+type IPCFormat int
+
+const (
+	// IPCStream is the streaming format, a sequence of record batches of arbitrary length.
+	IPCStream = IPCFormat(iota)
+	// IPCFile is the random access file format, also known as Feather v2.
+	IPCFile
+)
+
+// NewBowFromIPC returns a new Bow from the Arrow IPC data read from `r` in the format `format`.
+// The record batches are appended into a single Bow, which keeps the metadata of the schema.
+// The file format requires reading the whole data from `r` before decoding it.
+func NewBowFromIPC(r io.Reader, format IPCFormat) (Bow, error) {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+
+	var schema *arrow.Schema
+	var records []arrow.Record
+	switch format {
+	case IPCStream:
+		reader, err := ipc.NewReader(r, ipc.WithAllocator(mem))
+		if err != nil {
+			return nil, fmt.Errorf("ipc.NewReader: %w", err)
+		}
+		defer reader.Release()
+
+		schema = reader.Schema()
+		for reader.Next() {
+			rec := reader.Record()
+			rec.Retain()
+			records = append(records, rec)
+		}
+		if err = reader.Err(); err != nil {
+			return nil, fmt.Errorf("ipc.Reader.Next: %w", err)
+		}
+	case IPCFile:
+		data, err := io.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("io.ReadAll: %w", err)
+		}
+
+		reader, err := ipc.NewFileReader(bytes.NewReader(data), ipc.WithAllocator(mem))
+		if err != nil {
+			return nil, fmt.Errorf("ipc.NewFileReader: %w", err)
+		}
+		defer reader.Close()
+
+		schema = reader.Schema()
+		for i := 0; i < reader.NumRecords(); i++ {
+			rec, err := reader.Record(i)
+			if err != nil {
+				return nil, fmt.Errorf("ipc.FileReader.Record: %w", err)
+			}
+			rec.Retain()
+			records = append(records, rec)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported IPC format '%d'", format)
+	}
+
+	for _, field := range schema.Fields() {
+		if getBowTypeFromArrowType(field.Type) == Unknown {
+			return nil, fmt.Errorf("unsupported type '%s' for column '%s'", field.Type, field.Name)
+		}
+	}
+
+	if len(records) == 0 {
+		series := make([]Series, len(schema.Fields()))
+		for i, field := range schema.Fields() {
+			series[i] = NewSeriesFromBuffer(field.Name, NewBuffer(0, getBowTypeFromArrowType(field.Type)))
+		}
+		return NewBowWithMetadata(Metadata{schema.Metadata()}, series...)
+	}
+
+	// The records are rebuilt from their Series to rescale their decimal columns
+	bows := make([]Bow, len(records))
+	for i, rec := range records {
+		series := make([]Series, rec.NumCols())
+		for colIndex, col := range rec.Columns() {
+			series[colIndex] = Series{Name: rec.ColumnName(colIndex), Array: col}
+		}
+		var err error
+		if bows[i], err = NewBowWithMetadata(Metadata{rec.Schema().Metadata()}, series...); err != nil {
+			return nil, err
+		}
+	}
+
+	return AppendBows(bows...)
+}
+
+// WriteIPC writes the Bow to `w` in the Arrow IPC format `format`, as a single record batch.
+func (b *bow) WriteIPC(w io.Writer, format IPCFormat) error {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+
+	var writer interface {
+		Write(rec arrow.Record) error
+		Close() error
+	}
+	switch format {
+	case IPCStream:
+		writer = ipc.NewWriter(w, ipc.WithSchema(b.Schema()), ipc.WithAllocator(mem))
+	case IPCFile:
+		fileWriter, err := ipc.NewFileWriter(&positionWriter{w: w}, ipc.WithSchema(b.Schema()), ipc.WithAllocator(mem))
+		if err != nil {
+			return fmt.Errorf("ipc.NewFileWriter: %w", err)
+		}
+		writer = fileWriter
+	default:
+		return fmt.Errorf("unsupported IPC format '%d'", format)
+	}
+
+	if err := writer.Write(b.Record); err != nil {
+		return fmt.Errorf("ipc.Writer.Write: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("ipc.Writer.Close: %w", err)
+	}
+
+	return nil
+}
+
+// positionWriter is an io.WriteSeeker over an io.Writer, only able to report its current position,
+// which is the only use of Seek by ipc.FileWriter.
+type positionWriter struct {
+	w   io.Writer
+	pos int64
+}
+
+func (p *positionWriter) Write(data []byte) (int, error) {
+	n, err := p.w.Write(data)
+	p.pos += int64(n)
+	return n, err
+}
+
+func (p *positionWriter) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekCurrent {
+		return p.pos, fmt.Errorf("positionWriter can only report its current position")
+	}
+	return p.pos, nil
+}
@@ -0,0 +1,57 @@
+package bow
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow/go/v8/arrow/ipc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPC(t *testing.T) {
+	b, err := NewBowFromRowBasedInterfaces(
+		[]string{"time", "value", "category", "energy", "readings"},
+		[]Type{TimestampMilli, Float64, Dictionary, Decimal, List},
+		[][]interface{}{
+			{1, 1.5, "a", "0.1", []interface{}{1., 2.}},
+			{2, nil, nil, nil, nil},
+			{3, 3.5, "b", "-3", []interface{}{}},
+		})
+	require.NoError(t, err)
+	b = b.SetMetadata("source", "test")
+
+	for _, format := range []IPCFormat{IPCStream, IPCFile} {
+		t.Run(map[IPCFormat]string{IPCStream: "stream", IPCFile: "file"}[format], func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, b.WriteIPC(&buf, format))
+
+			res, err := NewBowFromIPC(&buf, format)
+			require.NoError(t, err)
+			ExpectEqual(t, b, res)
+			assert.Equal(t, b.Metadata(), res.Metadata())
+		})
+	}
+
+	t.Run("multi-record stream", func(t *testing.T) {
+		var buf bytes.Buffer
+		writer := ipc.NewWriter(&buf, ipc.WithSchema(b.Schema()))
+		require.NoError(t, writer.Write(*b.NewSlice(0, 1).ArrowRecord()))
+		require.NoError(t, writer.Write(*b.NewSlice(1, 3).ArrowRecord()))
+		require.NoError(t, writer.Close())
+
+		res, err := NewBowFromIPC(&buf, IPCStream)
+		require.NoError(t, err)
+		ExpectEqual(t, b, res)
+		assert.Equal(t, b.Metadata(), res.Metadata())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, b.NewEmptySlice().WriteIPC(&buf, IPCStream))
+
+		res, err := NewBowFromIPC(&buf, IPCStream)
+		require.NoError(t, err)
+		ExpectEqual(t, b.NewEmptySlice(), res)
+	})
+}
@@ -1,10 +1,12 @@
 package bow
 
 import (
+	"encoding/binary"
 	"fmt"
-	"sort"
+	"math"
 
-	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/decimal128"
 )
 
 // InnerJoin joins columns of two Bows on common columns and rows.
@@ -32,28 +34,17 @@ func (b *bow) InnerJoin(other Bow) Bow {
 	commonCols := getCommonCols(left, right)
 
 	// Get common rows indices
-	commonRows := getCommonRows(left, right, commonCols)
-
-	// Prepare new Series Slice
-	newNumCols := left.NumCols() + right.NumCols() - len(commonCols)
-	newSeries := make([]Series, newNumCols)
-	newNumRows := len(commonRows.l)
-
-	innerFillLeftBowCols(&newSeries, left,
-		newNumRows, commonRows)
-	innerFillRightBowCols(&newSeries, left, right,
-		newNumRows, newNumCols, commonCols, commonRows)
-
-	// Join Metadata
-	var keys, values []string
-	keys = append(keys, left.Schema().Metadata().Keys()...)
-	keys = append(keys, right.Schema().Metadata().Keys()...)
-	values = append(values, left.Schema().Metadata().Values()...)
-	values = append(values, right.Schema().Metadata().Values()...)
+	var commonRows CommonRows
+	for leftRow, rightRows := range getMatchingRows(left, right, commonCols) {
+		for _, rightRow := range rightRows {
+			commonRows.l = append(commonRows.l, leftRow)
+			commonRows.r = append(commonRows.r, rightRow)
+		}
+	}
 
 	newBow, err := NewBowWithMetadata(
-		NewMetadata(keys, values),
-		newSeries...)
+		joinMetadata(left, right),
+		joinSeries(left, right, commonCols, commonRows)...)
 	if err != nil {
 		panic(err)
 	}
@@ -73,61 +64,259 @@ func (b *bow) OuterJoin(other Bow) Bow {
 	// Get common columns indices
 	commonCols := getCommonCols(left, right)
 
-	// Get common rows indices
-	commonRows := getCommonRows(left, right, commonCols)
-
-	// Compute new rows number
-	var uniquesLeft, uniquesRight int
-	if len(commonRows.l) > 0 {
-		uniquesLeft, uniquesRight = 1, 1
-		sortedLeft := make([]int, len(commonRows.l))
-		sortedRight := make([]int, len(commonRows.l))
-		copy(sortedLeft, commonRows.l)
-		copy(sortedRight, commonRows.r)
-		sort.Ints(sortedLeft)
-		sort.Ints(sortedRight)
-		for i := 0; i < len(commonRows.l)-1; i++ {
-			if sortedLeft[i] != sortedLeft[i+1] {
-				uniquesLeft++
+	// Get joined rows indices: left rows with their matching right rows, then the unmatched right rows
+	var joinedRows CommonRows
+	isRightRowMatched := make([]bool, right.NumRows())
+	for leftRow, rightRows := range getMatchingRows(left, right, commonCols) {
+		if len(rightRows) == 0 {
+			joinedRows.l = append(joinedRows.l, leftRow)
+			joinedRows.r = append(joinedRows.r, -1)
+			continue
+		}
+		for _, rightRow := range rightRows {
+			joinedRows.l = append(joinedRows.l, leftRow)
+			joinedRows.r = append(joinedRows.r, rightRow)
+			isRightRowMatched[rightRow] = true
+		}
+	}
+	for rightRow, isMatched := range isRightRowMatched {
+		if !isMatched {
+			joinedRows.l = append(joinedRows.l, -1)
+			joinedRows.r = append(joinedRows.r, rightRow)
+		}
+	}
+
+	newBow, err := NewBowWithMetadata(
+		joinMetadata(left, right),
+		joinSeries(left, right, commonCols, joinedRows)...)
+	if err != nil {
+		panic(err)
+	}
+
+	return newBow
+}
+
+// JoinKind is the kind of join performed by Bow.Join.
+type JoinKind int
+
+const (
+	// JoinInner keeps the pairs of left and right rows with matching keys.
+	JoinInner JoinKind = iota
+	// JoinLeft keeps the pairs of matching rows, and the left rows without match.
+	JoinLeft
+	// JoinRight keeps the pairs of matching rows, and the right rows without match.
+	JoinRight
+	// JoinFull keeps the pairs of matching rows, and the left and right rows without match.
+	JoinFull
+	// JoinSemi keeps the left rows with at least one match, with the left columns only.
+	JoinSemi
+	// JoinAnti keeps the left rows without match, with the left columns only.
+	JoinAnti
+)
+
+func (k JoinKind) String() string {
+	switch k {
+	case JoinInner:
+		return "inner"
+	case JoinLeft:
+		return "left"
+	case JoinRight:
+		return "right"
+	case JoinFull:
+		return "full"
+	case JoinSemi:
+		return "semi"
+	case JoinAnti:
+		return "anti"
+	default:
+		return fmt.Sprintf("JoinKind(%d)", int(k))
+	}
+}
+
+// JoinOptions are options to join two Bows with Bow.Join:
+// - LeftOn: names of the key columns of the left Bow
+// - RightOn: names of the key columns of the right Bow, matched in order with LeftOn, defaults to LeftOn
+// - Kind: kind of join, defaults to JoinInner
+// - LeftSuffix: suffix appended to the names of the left non-key columns also found in the right non-key columns
+// - RightSuffix: suffix appended to the names of the right non-key columns also found in the left columns,
+// defaults to "_right" if LeftSuffix is also empty
+type JoinOptions struct {
+	LeftOn      []string
+	RightOn     []string
+	Kind        JoinKind
+	LeftSuffix  string
+	RightSuffix string
+}
+
+func (o *JoinOptions) validate() error {
+	if len(o.LeftOn) == 0 {
+		return fmt.Errorf("at least one key column is required")
+	}
+	if o.RightOn == nil {
+		o.RightOn = o.LeftOn
+	}
+	if len(o.RightOn) != len(o.LeftOn) {
+		return fmt.Errorf("found %d right key columns for %d left key columns", len(o.RightOn), len(o.LeftOn))
+	}
+	if o.Kind < JoinInner || o.Kind > JoinAnti {
+		return fmt.Errorf("unknown join kind '%s'", o.Kind)
+	}
+	if o.LeftSuffix == "" && o.RightSuffix == "" {
+		o.RightSuffix = "_right"
+	}
+	return nil
+}
+
+// Join joins the rows of two Bows having the same values on the key columns given by `options`.
+// The result holds the left columns followed by the right non-key columns,
+// the key columns keeping their left name and holding the right values for the right rows without match.
+// Nil key values match each other, and NaN float values match no value, as with InnerJoin and OuterJoin.
+// Rows are ordered by left row, then by right row, followed by the right rows without match for JoinFull.
+// JoinRight rows are ordered by right row, then by left row.
+// The Metadata of the two Bows are also joined by appending keys and values.
+func (b *bow) Join(other Bow, options JoinOptions) (Bow, error) {
+	left := b
+	right, ok := other.(*bow)
+	if !ok {
+		return nil, fmt.Errorf("non bow object passed as argument")
+	}
+
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+
+	keyCols := make([]joinCol, len(options.LeftOn))
+	for i := range keyCols {
+		leftColIndex, err := left.ColumnIndex(options.LeftOn[i])
+		if err != nil {
+			return nil, fmt.Errorf("left key column: %w", err)
+		}
+		rightColIndex, err := right.ColumnIndex(options.RightOn[i])
+		if err != nil {
+			return nil, fmt.Errorf("right key column: %w", err)
+		}
+		if left.ColumnType(leftColIndex) != right.ColumnType(rightColIndex) {
+			return nil, fmt.Errorf("left key column '%s' of type '%s' and right key column '%s' of type '%s' are incompatible",
+				options.LeftOn[i], left.ColumnType(leftColIndex), options.RightOn[i], right.ColumnType(rightColIndex))
+		}
+		keyCols[i] = joinCol{leftColIndex: leftColIndex, rightColIndex: rightColIndex}
+	}
+
+	var joinedRows CommonRows
+	switch options.Kind {
+	case JoinRight:
+		// Right rows are matched with their left rows, and the result is mirrored back
+		for rightRow, leftRows := range getMatchingRows(right, left, mirrorJoinCols(keyCols)) {
+			if len(leftRows) == 0 {
+				leftRows = []int{-1}
 			}
-			if sortedRight[i] != sortedRight[i+1] {
-				uniquesRight++
+			for _, leftRow := range leftRows {
+				joinedRows.l = append(joinedRows.l, leftRow)
+				joinedRows.r = append(joinedRows.r, rightRow)
+			}
+		}
+	default:
+		isRightRowMatched := make([]bool, right.NumRows())
+		for leftRow, rightRows := range getMatchingRows(left, right, keyCols) {
+			switch options.Kind {
+			case JoinSemi:
+				if len(rightRows) > 0 {
+					joinedRows.l = append(joinedRows.l, leftRow)
+				}
+			case JoinAnti:
+				if len(rightRows) == 0 {
+					joinedRows.l = append(joinedRows.l, leftRow)
+				}
+			default:
+				if len(rightRows) == 0 && (options.Kind == JoinLeft || options.Kind == JoinFull) {
+					joinedRows.l = append(joinedRows.l, leftRow)
+					joinedRows.r = append(joinedRows.r, -1)
+				}
+				for _, rightRow := range rightRows {
+					joinedRows.l = append(joinedRows.l, leftRow)
+					joinedRows.r = append(joinedRows.r, rightRow)
+					isRightRowMatched[rightRow] = true
+				}
+			}
+		}
+		if options.Kind == JoinFull {
+			for rightRow, isMatched := range isRightRowMatched {
+				if !isMatched {
+					joinedRows.l = append(joinedRows.l, -1)
+					joinedRows.r = append(joinedRows.r, rightRow)
+				}
 			}
 		}
 	}
-	newNumRows := left.NumRows() + right.NumRows() +
-		len(commonRows.l) - uniquesLeft - uniquesRight
 
-	// Prepare new Series Slice
-	newNumCols := left.NumCols() + right.NumCols() - len(commonCols)
-	newSeries := make([]Series, newNumCols)
+	var series []Series
+	if options.Kind == JoinSemi || options.Kind == JoinAnti {
+		series = make([]Series, left.NumCols())
+		for colIndex := range series {
+			series[colIndex] = NewSeriesFromBuffer(left.ColumnName(colIndex),
+				left.NewBufferFromCol(colIndex).newBufferFromIndices(joinedRows.l))
+		}
+	} else {
+		series = joinSeries(left, right, keyCols, joinedRows)
+		if err := renameJoinSeries(series, left.NumCols(), keyCols, options.LeftSuffix, options.RightSuffix); err != nil {
+			return nil, err
+		}
+	}
 
-	outerFillLeftBowCols(&newSeries, left, right, newNumRows,
-		uniquesLeft, commonCols, commonRows)
-	outerFillRightBowCols(&newSeries, left, right, newNumCols,
-		newNumRows, uniquesLeft, commonCols, commonRows)
+	return NewBowWithMetadata(joinMetadata(left, right), series...)
+}
 
-	// Join Metadata
-	var keys, values []string
-	keys = append(keys, left.Schema().Metadata().Keys()...)
-	keys = append(keys, right.Schema().Metadata().Keys()...)
-	values = append(values, left.Schema().Metadata().Values()...)
-	values = append(values, right.Schema().Metadata().Values()...)
+// renameJoinSeries appends the suffixes to the names of the non-key columns found on both sides,
+// the `numLeftCols` first Series being the left ones.
+func renameJoinSeries(series []Series, numLeftCols int, keyCols []joinCol, leftSuffix, rightSuffix string) error {
+	isLeftKey := make(map[int]bool, len(keyCols))
+	for _, col := range keyCols {
+		isLeftKey[col.leftColIndex] = true
+	}
 
-	newBow, err := NewBowWithMetadata(
-		NewMetadata(keys, values),
-		newSeries...)
-	if err != nil {
-		panic(err)
+	leftNames := make(map[string]int, numLeftCols)
+	for colIndex := 0; colIndex < numLeftCols; colIndex++ {
+		leftNames[series[colIndex].Name] = colIndex
 	}
 
-	return newBow
+	for colIndex := numLeftCols; colIndex < len(series); colIndex++ {
+		leftColIndex, isConflicting := leftNames[series[colIndex].Name]
+		if !isConflicting {
+			continue
+		}
+		if !isLeftKey[leftColIndex] {
+			series[leftColIndex].Name += leftSuffix
+		}
+		series[colIndex].Name += rightSuffix
+	}
+
+	names := make(map[string]bool, len(series))
+	for _, s := range series {
+		if names[s.Name] {
+			return fmt.Errorf("several columns '%s' after joining, use suffixes", s.Name)
+		}
+		names[s.Name] = true
+	}
+	return nil
+}
+
+// joinCol is a pair of left and right columns on which rows are joined.
+type joinCol struct {
+	leftColIndex, rightColIndex int
 }
 
-// getCommonCols returns in key column names and corresponding buffers on left / right schemas
-func getCommonCols(left, right Bow) map[string][]Buffer {
-	commonCols := make(map[string][]Buffer)
-	for _, lField := range left.Schema().Fields() {
+func mirrorJoinCols(joinCols []joinCol) []joinCol {
+	res := make([]joinCol, len(joinCols))
+	for i, col := range joinCols {
+		res[i] = joinCol{leftColIndex: col.rightColIndex, rightColIndex: col.leftColIndex}
+	}
+	return res
+}
+
+// getCommonCols returns the pairs of left and right columns having the same name, in the left column order.
+func getCommonCols(left, right Bow) []joinCol {
+	var commonCols []joinCol
+	for leftColIndex, lField := range left.Schema().Fields() {
 		rFields, commonCol := right.Schema().FieldsByName(lField.Name)
 		if !commonCol {
 			continue
@@ -139,436 +328,211 @@ func getCommonCols(left, right Bow) map[string][]Buffer {
 				right.String(), left.String()))
 		}
 
-		rField := rFields[0]
-		if rField.Type.ID() != lField.Type.ID() {
+		rightColIndex := right.Schema().FieldIndices(lField.Name)[0]
+		if left.ColumnType(leftColIndex) != right.ColumnType(rightColIndex) {
 			panic(fmt.Errorf(
 				"left and right bow on join columns are of incompatible types: %s",
 				lField.Name))
 		}
 
-		commonCols[lField.Name] = []Buffer{
-			left.NewBufferFromCol(left.Schema().FieldIndices(lField.Name)[0]),
-			right.NewBufferFromCol(right.Schema().FieldIndices(lField.Name)[0])}
+		commonCols = append(commonCols, joinCol{
+			leftColIndex:  leftColIndex,
+			rightColIndex: rightColIndex,
+		})
 	}
 
 	return commonCols
 }
 
+// CommonRows holds the left and right row indices of each joined row, -1 meaning no row.
 type CommonRows struct {
 	l, r []int
 }
 
-func getCommonRows(left, right Bow, commonColBufs map[string][]Buffer) CommonRows {
-	var commonRows CommonRows
-
-	if len(commonColBufs) == 0 {
-		return commonRows
+// getMatchingRows returns for each left row the right rows having the same values on the `joinCols`,
+// in ascending order. Nil values match each other.
+// A hash table is built over the key values of the Bow having the fewer rows, and probed with the other one.
+func getMatchingRows(left, right *bow, joinCols []joinCol) [][]int {
+	res := make([][]int, left.NumRows())
+	if len(joinCols) == 0 {
+		return res
 	}
 
-	for leftRow := 0; leftRow < left.NumRows(); leftRow++ {
-		for rightRow := 0; rightRow < right.NumRows(); rightRow++ {
-			isRowCommon := true
-			for _, colBufs := range commonColBufs {
-				if colBufs[0].GetValue(leftRow) != colBufs[1].GetValue(rightRow) {
-					isRowCommon = false
-					continue
-				}
-			}
+	leftBufs := make([]Buffer, len(joinCols))
+	rightBufs := make([]Buffer, len(joinCols))
+	for i, col := range joinCols {
+		leftBufs[i] = left.NewBufferFromCol(col.leftColIndex)
+		rightBufs[i] = right.NewBufferFromCol(col.rightColIndex)
+	}
 
-			if isRowCommon {
-				commonRows.l = append(commonRows.l, leftRow)
-				commonRows.r = append(commonRows.r, rightRow)
+	var key []byte
+	if right.NumRows() <= left.NumRows() {
+		table := newJoinHashTable(rightBufs, right.NumRows())
+		for leftRow := range res {
+			var ok bool
+			if key, ok = appendJoinKey(key[:0], leftBufs, leftRow); ok {
+				res[leftRow] = table[string(key)]
 			}
 		}
+		return res
 	}
 
-	return commonRows
+	table := newJoinHashTable(leftBufs, left.NumRows())
+	for rightRow := 0; rightRow < right.NumRows(); rightRow++ {
+		var ok bool
+		if key, ok = appendJoinKey(key[:0], rightBufs, rightRow); !ok {
+			continue
+		}
+		for _, leftRow := range table[string(key)] {
+			res[leftRow] = append(res[leftRow], rightRow)
+		}
+	}
+	return res
 }
 
-func innerFillLeftBowCols(newSeries *[]Series, left *bow, newNumRows int,
-	commonRows struct{ l, r []int }) {
-
-	for colIndex := 0; colIndex < left.NumCols(); colIndex++ {
-		buf := NewBuffer(newNumRows, left.ColumnType(colIndex))
-		switch buf.DataType {
-		case Int64:
-			data := array.NewInt64Data(left.Column(colIndex).Data())
-			for rowIndex := 0; rowIndex < newNumRows; rowIndex++ {
-				if data.IsValid(commonRows.l[rowIndex]) {
-					buf.SetOrDropStrict(rowIndex, data.Value(commonRows.l[rowIndex]))
-				}
-			}
-		case Float64:
-			data := array.NewFloat64Data(left.Column(colIndex).Data())
-			for rowIndex := 0; rowIndex < newNumRows; rowIndex++ {
-				if data.IsValid(commonRows.l[rowIndex]) {
-					buf.SetOrDropStrict(rowIndex, data.Value(commonRows.l[rowIndex]))
-				}
-			}
-		case Boolean:
-			data := array.NewBooleanData(left.Column(colIndex).Data())
-			for rowIndex := 0; rowIndex < newNumRows; rowIndex++ {
-				if data.IsValid(commonRows.l[rowIndex]) {
-					buf.SetOrDropStrict(rowIndex, data.Value(commonRows.l[rowIndex]))
-				}
-			}
-		case String:
-			data := array.NewStringData(left.Column(colIndex).Data())
-			for rowIndex := 0; rowIndex < newNumRows; rowIndex++ {
-				if data.IsValid(commonRows.l[rowIndex]) {
-					buf.SetOrDropStrict(rowIndex, data.Value(commonRows.l[rowIndex]))
-				}
-			}
-		default:
-			panic(fmt.Errorf("unsupported type '%s'", buf.DataType))
+// newJoinHashTable returns the row indices of `bufs` by join key, in ascending order.
+// The rows with a NaN key value are left out, as they match no row.
+func newJoinHashTable(bufs []Buffer, numRows int) map[string][]int {
+	table := make(map[string][]int, numRows)
+	var key []byte
+	var ok bool
+	for rowIndex := 0; rowIndex < numRows; rowIndex++ {
+		if key, ok = appendJoinKey(key[:0], bufs, rowIndex); ok {
+			table[string(key)] = append(table[string(key)], rowIndex)
 		}
-
-		(*newSeries)[colIndex] = NewSeriesFromBuffer(left.ColumnName(colIndex), buf)
 	}
+	return table
 }
 
-func innerFillRightBowCols(newSeries *[]Series, left, right *bow, newNumRows, newNumCols int,
-	commonCols map[string][]Buffer, commonRows struct{ l, r []int }) {
-	var rightCol int
-
-	for colIndex := left.NumCols(); colIndex < newNumCols; colIndex++ {
-		buf := NewBuffer(newNumRows, right.ColumnType(rightCol))
-		for commonCols[right.ColumnName(rightCol)] != nil {
-			rightCol++
+// appendJoinKey appends to `key` a binary encoding of the values of `bufs` at row `rowIndex`,
+// such that rows with equal values have equal keys.
+// Returns false if one of the values is NaN, as NaN values are not equal to any value, including themselves.
+func appendJoinKey(key []byte, bufs []Buffer, rowIndex int) ([]byte, bool) {
+	for _, buf := range bufs {
+		if buf.isNaN(rowIndex) {
+			return key, false
 		}
-
-		// Fill common rows from right bow
-		switch buf.DataType {
-		case Int64:
-			data := array.NewInt64Data(right.Column(rightCol).Data())
-			for rowIndex := 0; rowIndex < newNumRows; rowIndex++ {
-				if data.IsValid(commonRows.r[rowIndex]) {
-					buf.SetOrDropStrict(rowIndex, data.Value(commonRows.r[rowIndex]))
-				}
-			}
-		case Float64:
-			data := array.NewFloat64Data(right.Column(rightCol).Data())
-			for rowIndex := 0; rowIndex < newNumRows; rowIndex++ {
-				if data.IsValid(commonRows.r[rowIndex]) {
-					buf.SetOrDropStrict(rowIndex, data.Value(commonRows.r[rowIndex]))
-				}
-			}
-		case Boolean:
-			data := array.NewBooleanData(right.Column(rightCol).Data())
-			for rowIndex := 0; rowIndex < newNumRows; rowIndex++ {
-				if data.IsValid(commonRows.r[rowIndex]) {
-					buf.SetOrDropStrict(rowIndex, data.Value(commonRows.r[rowIndex]))
-				}
-			}
-		case String:
-			data := array.NewStringData(right.Column(rightCol).Data())
-			for rowIndex := 0; rowIndex < newNumRows; rowIndex++ {
-				if data.IsValid(commonRows.r[rowIndex]) {
-					buf.SetOrDropStrict(rowIndex, data.Value(commonRows.r[rowIndex]))
-				}
+		if buf.IsNull(rowIndex) {
+			key = append(key, 0)
+			continue
+		}
+		key = append(key, 1)
+
+		switch data := buf.Data.(type) {
+		case []int64:
+			key = appendJoinKeyUint64(key, uint64(data[rowIndex]))
+		case []float64:
+			key = appendJoinKeyUint64(key, math.Float64bits(normalizeJoinKeyFloat64(data[rowIndex])))
+		case []bool:
+			if data[rowIndex] {
+				key = append(key, 1)
+			} else {
+				key = append(key, 0)
 			}
+		case []string:
+			key = appendJoinKeyUint64(key, uint64(len(data[rowIndex])))
+			key = append(key, data[rowIndex]...)
+		case []arrow.Timestamp:
+			key = appendJoinKeyUint64(key, uint64(data[rowIndex]))
+		case []int8:
+			key = appendJoinKeyUint64(key, uint64(data[rowIndex]))
+		case []int16:
+			key = appendJoinKeyUint64(key, uint64(data[rowIndex]))
+		case []int32:
+			key = appendJoinKeyUint64(key, uint64(data[rowIndex]))
+		case []uint8:
+			key = appendJoinKeyUint64(key, uint64(data[rowIndex]))
+		case []uint16:
+			key = appendJoinKeyUint64(key, uint64(data[rowIndex]))
+		case []uint32:
+			key = appendJoinKeyUint64(key, uint64(data[rowIndex]))
+		case []uint64:
+			key = appendJoinKeyUint64(key, data[rowIndex])
+		case []float32:
+			key = appendJoinKeyUint64(key, math.Float64bits(normalizeJoinKeyFloat64(float64(data[rowIndex]))))
+		case []Decimal128:
+			// Decimal values all have the same scale, so equal values have equal bits
+			num := decimal128.Num(data[rowIndex])
+			key = appendJoinKeyUint64(key, uint64(num.HighBits()))
+			key = appendJoinKeyUint64(key, num.LowBits())
+		case []arrow.Date32:
+			key = appendJoinKeyUint64(key, uint64(data[rowIndex]))
+		case []arrow.Duration:
+			key = appendJoinKeyUint64(key, uint64(data[rowIndex]))
+		case [][]byte:
+			key = appendJoinKeyUint64(key, uint64(len(data[rowIndex])))
+			key = append(key, data[rowIndex]...)
 		default:
-			panic(fmt.Errorf("unsupported type '%s'", buf.DataType))
+			// List and Struct values are encoded with their formatted string
+			value := fmt.Sprint(buf.GetValue(rowIndex))
+			key = appendJoinKeyUint64(key, uint64(len(value)))
+			key = append(key, value...)
 		}
+	}
+	return key, true
+}
 
-		(*newSeries)[colIndex] = NewSeriesFromBuffer(right.ColumnName(rightCol), buf)
-		rightCol++
+// normalizeJoinKeyFloat64 returns the same bits for the equal values -0 and +0.
+func normalizeJoinKeyFloat64(value float64) float64 {
+	if value == 0 {
+		return 0
 	}
+	return value
+}
+
+func appendJoinKeyUint64(key []byte, value uint64) []byte {
+	var bytes [8]byte
+	binary.LittleEndian.PutUint64(bytes[:], value)
+	return append(key, bytes[:]...)
 }
 
-func outerFillLeftBowCols(newSeries *[]Series, left, right *bow, newNumRows, uniquesLeft int,
-	commonCols map[string][]Buffer, commonRows struct{ l, r []int }) {
-	var leftRow, commonRow int
+// joinSeries returns the left columns followed by the right columns not in `commonCols`,
+// with the rows given by `joinedRows`.
+// The values of the common columns come from the right Bow for the rows without left row.
+func joinSeries(left, right *bow, commonCols []joinCol, joinedRows CommonRows) []Series {
+	isRightColCommon := make([]bool, right.NumCols())
+	rightColIndices := make([]int, left.NumCols())
+	for i := range rightColIndices {
+		rightColIndices[i] = -1
+	}
+	for _, col := range commonCols {
+		isRightColCommon[col.rightColIndex] = true
+		rightColIndices[col.leftColIndex] = col.rightColIndex
+	}
 
+	newSeries := make([]Series, 0, left.NumCols()+right.NumCols()-len(commonCols))
 	for colIndex := 0; colIndex < left.NumCols(); colIndex++ {
-		leftRow = 0
-		commonRow = 0
-		buf := NewBuffer(newNumRows, left.ColumnType(colIndex))
-
-		// Fill rows from left bow
-		switch buf.DataType {
-		case Int64:
-			data := array.NewInt64Data(left.Column(colIndex).Data())
-			for newRow := 0; left.NumRows() > 0 && newRow < newNumRows; newRow++ {
-				if data.IsValid(leftRow) {
-					buf.SetOrDropStrict(newRow, data.Value(leftRow))
-				}
-				for commonRow < len(commonRows.l) &&
-					leftRow == commonRows.l[commonRow] &&
-					newRow < newNumRows {
-					if data.IsValid(leftRow) {
-						buf.SetOrDropStrict(newRow, data.Value(leftRow))
-					}
-					if commonRow+1 < len(commonRows.l) &&
-						commonRows.l[commonRow+1] == leftRow {
-						newRow++
-					}
-					commonRow++
-				}
-				if leftRow++; leftRow >= left.NumRows() {
-					break
-				}
-			}
-		case Float64:
-			data := array.NewFloat64Data(left.Column(colIndex).Data())
-			for newRow := 0; left.NumRows() > 0 && newRow < newNumRows; newRow++ {
-				if data.IsValid(leftRow) {
-					buf.SetOrDropStrict(newRow, data.Value(leftRow))
-				}
-				for commonRow < len(commonRows.l) &&
-					leftRow == commonRows.l[commonRow] &&
-					newRow < newNumRows {
-					if data.IsValid(leftRow) {
-						buf.SetOrDropStrict(newRow, data.Value(leftRow))
-					}
-					if commonRow+1 < len(commonRows.l) &&
-						commonRows.l[commonRow+1] == leftRow {
-						newRow++
-					}
-					commonRow++
-				}
-				if leftRow++; leftRow >= left.NumRows() {
-					break
-				}
-			}
-		case Boolean:
-			data := array.NewBooleanData(left.Column(colIndex).Data())
-			for newRow := 0; left.NumRows() > 0 && newRow < newNumRows; newRow++ {
-				if data.IsValid(leftRow) {
-					buf.SetOrDropStrict(newRow, data.Value(leftRow))
-				}
-				for commonRow < len(commonRows.l) &&
-					leftRow == commonRows.l[commonRow] &&
-					newRow < newNumRows {
-					if data.IsValid(leftRow) {
-						buf.SetOrDropStrict(newRow, data.Value(leftRow))
-					}
-					if commonRow+1 < len(commonRows.l) &&
-						commonRows.l[commonRow+1] == leftRow {
-						newRow++
-					}
-					commonRow++
-				}
-				if leftRow++; leftRow >= left.NumRows() {
-					break
-				}
-			}
-		case String:
-			data := array.NewStringData(left.Column(colIndex).Data())
-			for newRow := 0; left.NumRows() > 0 && newRow < newNumRows; newRow++ {
-				if data.IsValid(leftRow) {
-					buf.SetOrDropStrict(newRow, data.Value(leftRow))
-				}
-				for commonRow < len(commonRows.l) &&
-					leftRow == commonRows.l[commonRow] &&
-					newRow < newNumRows {
-					if data.IsValid(leftRow) {
-						buf.SetOrDropStrict(newRow, data.Value(leftRow))
-					}
-					if commonRow+1 < len(commonRows.l) &&
-						commonRows.l[commonRow+1] == leftRow {
-						newRow++
-					}
-					commonRow++
-				}
-				if leftRow++; leftRow >= left.NumRows() {
-					break
-				}
-			}
-		default:
-			panic(fmt.Errorf("unsupported type '%s'", buf.DataType))
-		}
+		buf := left.NewBufferFromCol(colIndex).newBufferFromIndices(joinedRows.l)
 
-		// Fill remaining rows from right bow if column is common
-		_, isColCommon := commonCols[left.ColumnName(colIndex)]
-		var newRow int
-		if isColCommon {
-			newRow = left.NumRows() + len(commonRows.l) - uniquesLeft
-		}
-		for rightRow := 0; isColCommon && rightRow < right.NumRows(); rightRow++ {
-			var isRowCommon bool
-			for i := 0; i < len(commonRows.r); i++ {
-				if rightRow == commonRows.r[i] {
-					isRowCommon = true
-					break
+		// Fill the rows without left row from the right bow if the column is common
+		if rightColIndices[colIndex] != -1 {
+			rightBuf := right.NewBufferFromCol(rightColIndices[colIndex])
+			for rowIndex, leftRow := range joinedRows.l {
+				if leftRow == -1 {
+					buf.SetOrDropStrict(rowIndex, rightBuf.GetValue(joinedRows.r[rowIndex]))
 				}
 			}
-			if !isRowCommon {
-				buf.SetOrDropStrict(newRow, commonCols[left.ColumnName(colIndex)][1].GetValue(rightRow))
-				newRow++
-			}
 		}
 
-		(*newSeries)[colIndex] = NewSeriesFromBuffer(left.ColumnName(colIndex), buf)
+		newSeries = append(newSeries, NewSeriesFromBuffer(left.ColumnName(colIndex), buf))
 	}
-}
-
-func outerFillRightBowCols(newSeries *[]Series, left, right *bow, newNumCols,
-	newNumRows, uniquesLeft int, commonCols map[string][]Buffer,
-	commonRows struct{ l, r []int }) {
-	var leftRow, commonRow, rightCol int
 
-	for colIndex := left.NumCols(); colIndex < newNumCols; colIndex++ {
-		leftRow = 0
-		commonRow = 0
-		for commonCols[right.ColumnName(rightCol)] != nil {
-			rightCol++
+	for colIndex := 0; colIndex < right.NumCols(); colIndex++ {
+		if isRightColCommon[colIndex] {
+			continue
 		}
-		buf := NewBuffer(newNumRows, right.ColumnType(rightCol))
-
-		switch buf.DataType {
-		case Int64:
-			data := array.NewInt64Data(right.Column(rightCol).Data())
-
-			// Fill common rows from right bow
-			for newRow := 0; newRow < newNumRows; newRow++ {
-				for commonRow < len(commonRows.l) &&
-					leftRow == commonRows.l[commonRow] &&
-					newRow < newNumRows {
-					if data.IsValid(commonRows.r[commonRow]) {
-						buf.SetOrDropStrict(newRow, data.Value(commonRows.r[commonRow]))
-					}
-					if commonRow+1 < len(commonRows.l) &&
-						commonRows.l[commonRow+1] == leftRow {
-						newRow++
-					}
-					commonRow++
-				}
-				leftRow++
-			}
-
-			// Fill remaining rows from right bow
-			newRow := left.NumRows() + len(commonRows.r) - uniquesLeft
-			for rightRow := 0; rightRow < right.NumRows(); rightRow++ {
-				var isRowCommon bool
-				for i := 0; i < len(commonRows.r); i++ {
-					if rightRow == commonRows.r[i] {
-						isRowCommon = true
-						break
-					}
-				}
-				if !isRowCommon {
-					if data.IsValid(rightRow) {
-						buf.SetOrDropStrict(newRow, data.Value(rightRow))
-					}
-					newRow++
-				}
-			}
-		case Float64:
-			data := array.NewFloat64Data(right.Column(rightCol).Data())
-
-			// Fill common rows from right bow
-			for newRow := 0; newRow < newNumRows; newRow++ {
-				for commonRow < len(commonRows.l) &&
-					leftRow == commonRows.l[commonRow] &&
-					newRow < newNumRows {
-					if data.IsValid(commonRows.r[commonRow]) {
-						buf.SetOrDropStrict(newRow, data.Value(commonRows.r[commonRow]))
-					}
-					if commonRow+1 < len(commonRows.l) &&
-						commonRows.l[commonRow+1] == leftRow {
-						newRow++
-					}
-					commonRow++
-				}
-				leftRow++
-			}
-
-			// Fill remaining rows from right bow
-			newRow := left.NumRows() + len(commonRows.r) - uniquesLeft
-			for rightRow := 0; rightRow < right.NumRows(); rightRow++ {
-				var isRowCommon bool
-				for i := 0; i < len(commonRows.r); i++ {
-					if rightRow == commonRows.r[i] {
-						isRowCommon = true
-						break
-					}
-				}
-				if !isRowCommon {
-					if data.IsValid(rightRow) {
-						buf.SetOrDropStrict(newRow, data.Value(rightRow))
-					}
-					newRow++
-				}
-			}
-		case Boolean:
-			data := array.NewBooleanData(right.Column(rightCol).Data())
-
-			// Fill common rows from right bow
-			for newRow := 0; newRow < newNumRows; newRow++ {
-				for commonRow < len(commonRows.l) &&
-					leftRow == commonRows.l[commonRow] &&
-					newRow < newNumRows {
-					if data.IsValid(commonRows.r[commonRow]) {
-						buf.SetOrDropStrict(newRow, data.Value(commonRows.r[commonRow]))
-					}
-					if commonRow+1 < len(commonRows.l) &&
-						commonRows.l[commonRow+1] == leftRow {
-						newRow++
-					}
-					commonRow++
-				}
-				leftRow++
-			}
+		buf := right.NewBufferFromCol(colIndex).newBufferFromIndices(joinedRows.r)
+		newSeries = append(newSeries, NewSeriesFromBuffer(right.ColumnName(colIndex), buf))
+	}
 
-			// Fill remaining rows from right bow
-			newRow := left.NumRows() + len(commonRows.r) - uniquesLeft
-			for rightRow := 0; rightRow < right.NumRows(); rightRow++ {
-				var isRowCommon bool
-				for i := 0; i < len(commonRows.r); i++ {
-					if rightRow == commonRows.r[i] {
-						isRowCommon = true
-						break
-					}
-				}
-				if !isRowCommon {
-					if data.IsValid(rightRow) {
-						buf.SetOrDropStrict(newRow, data.Value(rightRow))
-					}
-					newRow++
-				}
-			}
-		case String:
-			data := array.NewStringData(right.Column(rightCol).Data())
-
-			// Fill common rows from right bow
-			for newRow := 0; newRow < newNumRows; newRow++ {
-				for commonRow < len(commonRows.l) &&
-					leftRow == commonRows.l[commonRow] &&
-					newRow < newNumRows {
-					if data.IsValid(commonRows.r[commonRow]) {
-						buf.SetOrDropStrict(newRow, data.Value(commonRows.r[commonRow]))
-					}
-					if commonRow+1 < len(commonRows.l) &&
-						commonRows.l[commonRow+1] == leftRow {
-						newRow++
-					}
-					commonRow++
-				}
-				leftRow++
-			}
+	return newSeries
+}
 
-			// Fill remaining rows from right bow
-			newRow := left.NumRows() + len(commonRows.r) - uniquesLeft
-			for rightRow := 0; rightRow < right.NumRows(); rightRow++ {
-				var isRowCommon bool
-				for i := 0; i < len(commonRows.r); i++ {
-					if rightRow == commonRows.r[i] {
-						isRowCommon = true
-						break
-					}
-				}
-				if !isRowCommon {
-					if data.IsValid(rightRow) {
-						buf.SetOrDropStrict(newRow, data.Value(rightRow))
-					}
-					newRow++
-				}
-			}
-		default:
-			panic(fmt.Errorf("unsupported type '%s'", buf.DataType))
-		}
-		(*newSeries)[colIndex] = NewSeriesFromBuffer(right.ColumnName(rightCol), buf)
-		rightCol++
-	}
+func joinMetadata(left, right Bow) Metadata {
+	var keys, values []string
+	keys = append(keys, left.Schema().Metadata().Keys()...)
+	keys = append(keys, right.Schema().Metadata().Keys()...)
+	values = append(values, left.Schema().Metadata().Values()...)
+	values = append(values, right.Schema().Metadata().Values()...)
+	return NewMetadata(keys, values)
 }
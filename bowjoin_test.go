@@ -2,8 +2,11 @@ package bow
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
+	"github.com/apache/arrow/go/v8/arrow"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -578,6 +581,18 @@ func TestBow_InnerJoin(t *testing.T) {
 		b1.InnerJoin(b2)
 	})
 
+	t.Run("timestamp columns of different units", func(t *testing.T) {
+		b1, err := NewBow(NewSeries("time", TimestampSec, []arrow.Timestamp{1, 2}, nil))
+		require.NoError(t, err)
+
+		b2, err := NewBow(NewSeries("time", TimestampMilli, []arrow.Timestamp{1, 2}, nil))
+		require.NoError(t, err)
+
+		assert.PanicsWithError(t, "left and right bow on join columns are of incompatible types: time", func() {
+			b1.InnerJoin(b2)
+		})
+	})
+
 	t.Run("no common columns", func(t *testing.T) {
 		b1, err := NewBow(
 			NewSeries("index1", Int64, []int64{1, 1, 2, 3, 4}, nil),
@@ -666,10 +681,273 @@ func TestBow_InnerJoin(t *testing.T) {
 		result := b1.InnerJoin(b2)
 		assert.EqualValues(t, expected.String(), result.String())
 	})
+
+	t.Run("smaller left bow keeps the row order", func(t *testing.T) {
+		b1, err := NewBowFromRowBasedInterfaces(
+			[]string{"A", "S", "B"},
+			[]Type{Int64, String, Int64}, [][]interface{}{
+				{2, "x", 1},
+				{1, "y", 2},
+				{2, "x", 3},
+			})
+		require.NoError(t, err)
+
+		b2, err := NewBowFromRowBasedInterfaces(
+			[]string{"C", "S", "A"},
+			[]Type{Int64, String, Int64}, [][]interface{}{
+				{10, "x", 2},
+				{11, "y", 1},
+				{12, "y", 2},
+				{13, "x", 2},
+				{14, "y", 1},
+			})
+		require.NoError(t, err)
+
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"A", "S", "B", "C"},
+			[]Type{Int64, String, Int64, Int64}, [][]interface{}{
+				{2, "x", 1, 10},
+				{2, "x", 1, 13},
+				{1, "y", 2, 11},
+				{1, "y", 2, 14},
+				{2, "x", 3, 10},
+				{2, "x", 3, 13},
+			})
+		require.NoError(t, err)
+
+		result := b1.InnerJoin(b2)
+		assert.EqualValues(t, expected.String(), result.String())
+
+		expected, err = NewBowFromRowBasedInterfaces(
+			[]string{"C", "S", "A", "B"},
+			[]Type{Int64, String, Int64, Int64}, [][]interface{}{
+				{10, "x", 2, 1},
+				{10, "x", 2, 3},
+				{11, "y", 1, 2},
+				{12, "y", 2, nil},
+				{13, "x", 2, 1},
+				{13, "x", 2, 3},
+				{14, "y", 1, 2},
+			})
+		require.NoError(t, err)
+
+		result = b2.OuterJoin(b1)
+		assert.EqualValues(t, expected.String(), result.String())
+	})
+}
+
+func TestBow_Join(t *testing.T) {
+	left, err := NewBowFromRowBasedInterfaces(
+		[]string{"id", "site", "value"},
+		[]Type{Int64, String, Float64}, [][]interface{}{
+			{1, "a", 1.1},
+			{2, "b", 2.2},
+			{3, "a", 3.3},
+			{1, "b", 4.4},
+		})
+	require.NoError(t, err)
+	left = left.SetMetadata("k1", "v1")
+
+	right, err := NewBowFromRowBasedInterfaces(
+		[]string{"device", "value", "label"},
+		[]Type{Int64, Float64, String}, [][]interface{}{
+			{3, 30., "c"},
+			{1, 10., "a"},
+			{4, 40., "d"},
+			{1, 11., "a2"},
+		})
+	require.NoError(t, err)
+
+	options := JoinOptions{LeftOn: []string{"id"}, RightOn: []string{"device"}}
+	joinedCols := []string{"id", "site", "value", "value_right", "label"}
+	joinedTypes := []Type{Int64, String, Float64, Float64, String}
+
+	for _, testCase := range []struct {
+		kind     JoinKind
+		cols     []string
+		types    []Type
+		expected [][]interface{}
+	}{
+		{
+			kind: JoinInner, cols: joinedCols, types: joinedTypes,
+			expected: [][]interface{}{
+				{1, "a", 1.1, 10., "a"},
+				{1, "a", 1.1, 11., "a2"},
+				{3, "a", 3.3, 30., "c"},
+				{1, "b", 4.4, 10., "a"},
+				{1, "b", 4.4, 11., "a2"},
+			},
+		},
+		{
+			kind: JoinLeft, cols: joinedCols, types: joinedTypes,
+			expected: [][]interface{}{
+				{1, "a", 1.1, 10., "a"},
+				{1, "a", 1.1, 11., "a2"},
+				{2, "b", 2.2, nil, nil},
+				{3, "a", 3.3, 30., "c"},
+				{1, "b", 4.4, 10., "a"},
+				{1, "b", 4.4, 11., "a2"},
+			},
+		},
+		{
+			kind: JoinRight, cols: joinedCols, types: joinedTypes,
+			expected: [][]interface{}{
+				{3, "a", 3.3, 30., "c"},
+				{1, "a", 1.1, 10., "a"},
+				{1, "b", 4.4, 10., "a"},
+				{4, nil, nil, 40., "d"},
+				{1, "a", 1.1, 11., "a2"},
+				{1, "b", 4.4, 11., "a2"},
+			},
+		},
+		{
+			kind: JoinFull, cols: joinedCols, types: joinedTypes,
+			expected: [][]interface{}{
+				{1, "a", 1.1, 10., "a"},
+				{1, "a", 1.1, 11., "a2"},
+				{2, "b", 2.2, nil, nil},
+				{3, "a", 3.3, 30., "c"},
+				{1, "b", 4.4, 10., "a"},
+				{1, "b", 4.4, 11., "a2"},
+				{4, nil, nil, 40., "d"},
+			},
+		},
+		{
+			kind: JoinSemi, cols: []string{"id", "site", "value"}, types: []Type{Int64, String, Float64},
+			expected: [][]interface{}{
+				{1, "a", 1.1},
+				{3, "a", 3.3},
+				{1, "b", 4.4},
+			},
+		},
+		{
+			kind: JoinAnti, cols: []string{"id", "site", "value"}, types: []Type{Int64, String, Float64},
+			expected: [][]interface{}{
+				{2, "b", 2.2},
+			},
+		},
+	} {
+		t.Run(testCase.kind.String(), func(t *testing.T) {
+			options := options
+			options.Kind = testCase.kind
+			res, err := left.Join(right, options)
+			require.NoError(t, err)
+
+			expected, err := NewBowFromRowBasedInterfaces(testCase.cols, testCase.types, testCase.expected)
+			require.NoError(t, err)
+			expected = expected.SetMetadata("k1", "v1")
+			ExpectEqual(t, expected, res)
+		})
+	}
+
+	t.Run("several keys and suffixes", func(t *testing.T) {
+		right, err := NewBowFromRowBasedInterfaces(
+			[]string{"site", "id", "value"},
+			[]Type{String, Int64, Float64}, [][]interface{}{
+				{"a", 1, 10.},
+				{"b", 1, 40.},
+			})
+		require.NoError(t, err)
+
+		res, err := left.Join(right, JoinOptions{
+			LeftOn:      []string{"id", "site"},
+			LeftSuffix:  "_l",
+			RightSuffix: "_r",
+		})
+		require.NoError(t, err)
+
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"id", "site", "value_l", "value_r"},
+			[]Type{Int64, String, Float64, Float64}, [][]interface{}{
+				{1, "a", 1.1, 10.},
+				{1, "b", 4.4, 40.},
+			})
+		require.NoError(t, err)
+		expected = expected.SetMetadata("k1", "v1")
+		ExpectEqual(t, expected, res)
+	})
+
+	t.Run("float keys", func(t *testing.T) {
+		negativeZero := math.Copysign(0, -1)
+		nan := math.NaN()
+		left, err := NewBow(
+			NewSeries("f64", Float64, []float64{negativeZero, nan, 1}, nil),
+			NewSeries("f32", Float32, []float32{float32(negativeZero), float32(nan), 1}, nil),
+			NewSeries("left", Int64, []int64{1, 2, 3}, nil),
+		)
+		require.NoError(t, err)
+		right, err := NewBow(
+			NewSeries("f64", Float64, []float64{nan, 0, 2}, nil),
+			NewSeries("f32", Float32, []float32{float32(nan), 0, 2}, nil),
+			NewSeries("right", Int64, []int64{10, 20, 30}, nil),
+		)
+		require.NoError(t, err)
+
+		// -0 matches +0, NaN values match no value
+		res, err := left.Join(right, JoinOptions{LeftOn: []string{"f64", "f32"}})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.NumRows())
+		assert.Equal(t, []interface{}{int64(1), int64(20)}, []interface{}{res.GetValue(2, 0), res.GetValue(3, 0)})
+
+		res = left.InnerJoin(right)
+		require.Equal(t, 1, res.NumRows())
+		assert.Equal(t, []interface{}{int64(1), int64(20)}, []interface{}{res.GetValue(2, 0), res.GetValue(3, 0)})
+
+		res = left.OuterJoin(right)
+		require.Equal(t, 5, res.NumRows())
+		for rowIndex, expected := range [][]interface{}{
+			{int64(1), int64(20)},
+			{int64(2), nil},
+			{int64(3), nil},
+			{nil, int64(10)},
+			{nil, int64(30)},
+		} {
+			assert.Equal(t, expected, []interface{}{res.GetValue(2, rowIndex), res.GetValue(3, rowIndex)})
+		}
+	})
+
+	t.Run("fixed-width keys", func(t *testing.T) {
+		left, err := NewBow(
+			NewSeries("i8", Int8, []int8{-1, 1}, nil),
+			NewSeries("u64", Uint64, []uint64{math.MaxUint64, 1}, nil),
+			NewSeries("duration", Duration, []arrow.Duration{-5, 5}, nil),
+			NewSeries("left", Int64, []int64{1, 2}, nil),
+		)
+		require.NoError(t, err)
+		right, err := NewBow(
+			NewSeries("i8", Int8, []int8{1, -1}, nil),
+			NewSeries("u64", Uint64, []uint64{1, math.MaxUint64}, nil),
+			NewSeries("duration", Duration, []arrow.Duration{5, 5}, nil),
+			NewSeries("right", Int64, []int64{10, 20}, nil),
+		)
+		require.NoError(t, err)
+
+		res, err := left.Join(right, JoinOptions{LeftOn: []string{"i8", "u64", "duration"}})
+		require.NoError(t, err)
+		require.Equal(t, 1, res.NumRows())
+		assert.Equal(t, []interface{}{int64(2), int64(10)}, []interface{}{res.GetValue(3, 0), res.GetValue(4, 0)})
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for name, options := range map[string]JoinOptions{
+			"no key":              {},
+			"unknown left key":    {LeftOn: []string{"unknown"}, RightOn: []string{"device"}},
+			"unknown right key":   {LeftOn: []string{"id"}},
+			"different key count": {LeftOn: []string{"id"}, RightOn: []string{"device", "label"}},
+			"incompatible types":  {LeftOn: []string{"id"}, RightOn: []string{"value"}},
+			"unknown kind":        {LeftOn: []string{"id"}, RightOn: []string{"device"}, Kind: JoinKind(42)},
+			"conflicting names":   {LeftOn: []string{"id"}, RightOn: []string{"device"}, RightSuffix: "_x", LeftSuffix: "_x"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := left.Join(right, options)
+				assert.Error(t, err)
+			})
+		}
+	})
 }
 
 func BenchmarkBow_Join(b *testing.B) {
-	for rows := 10; rows <= 10000; rows *= 10 {
+	for rows := 10; rows <= 100000; rows *= 10 {
 		leftBow, err := NewBowFromParquet(fmt.Sprintf(
 			"%sbow1-%d-rows.parquet", benchmarkBowsDirPath, rows), false)
 		require.NoError(b, err)
@@ -1,8 +1,15 @@
 package bow
 
 import (
+	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/apache/arrow/go/v8/arrow"
 )
 
 type jsonField struct {
@@ -10,8 +17,14 @@ type jsonField struct {
 	Type string `json:"type"`
 }
 
+type jsonKeyValue struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 type JSONSchema struct {
-	Fields []jsonField `json:"fields"`
+	Fields   []jsonField    `json:"fields"`
+	Metadata []jsonKeyValue `json:"metadata,omitempty"`
 }
 
 // JSONBow is a structure representing a Bow for JSON marshaling purpose.
@@ -20,57 +33,225 @@ type JSONBow struct {
 	RowBasedData []map[string]interface{} `json:"data"`
 }
 
+// JSONColBow is a structure representing a Bow column by column for JSON marshaling purpose.
+// Unlike JSONBow, it distinguishes nil values from missing ones.
+type JSONColBow struct {
+	Schema  JSONSchema   `json:"schema"`
+	NumRows int          `json:"num_rows"`
+	Columns []JSONColumn `json:"columns"`
+}
+
+// JSONColumn is a structure representing a column of a JSONColBow.
+// Data holds nil for the values whose Validity is false.
+type JSONColumn struct {
+	Name     string        `json:"name"`
+	Data     []interface{} `json:"data"`
+	Validity []bool        `json:"validity"`
+}
+
+// JSONOptions are options to encode a Bow to JSON:
+// - ColBased: encodes the Bow column by column as a JSONColBow instead of row by row as a JSONBow
+// - Int64AsString: encodes the Int64, Uint64, timestamp and Duration values as strings,
+// to keep their precision in consumers representing numbers as 64 bits floats, such as JavaScript
+// - Strict: when decoding, returns an error for the values which do not match the type of their column
+// and for the keys missing from the schema, instead of setting nil values
+type JSONOptions struct {
+	ColBased      bool
+	Int64AsString bool
+	Strict        bool
+}
+
 // MarshalJSON returns the marshal encoding of the bow.
 func (b bow) MarshalJSON() ([]byte, error) {
 	return json.Marshal(NewJSONBow(&b))
 }
 
+// MarshalJSONWithOptions returns the marshal encoding of the bow with the JSONOptions `options`.
+func (b *bow) MarshalJSONWithOptions(options JSONOptions) ([]byte, error) {
+	if options.ColBased {
+		return json.Marshal(NewJSONColBow(b, options))
+	}
+	return json.Marshal(NewJSONBowWithOptions(b, options))
+}
+
 // NewJSONBow returns a new JSONBow structure from a Bow.
 func NewJSONBow(b Bow) JSONBow {
+	return NewJSONBowWithOptions(b, JSONOptions{})
+}
+
+// NewJSONBowWithOptions returns a new JSONBow structure from a Bow, with the values encoded following `options`.
+// Option ColBased is ignored.
+func NewJSONBowWithOptions(b Bow, options JSONOptions) JSONBow {
 	if b == nil {
 		return JSONBow{}
 	}
 
 	res := JSONBow{
+		Schema:       newJSONSchema(b),
 		RowBasedData: make([]map[string]interface{}, 0, b.NumRows()),
 	}
 
-	for _, col := range b.Schema().Fields() {
-		res.Schema.Fields = append(
-			res.Schema.Fields,
-			jsonField{
-				Name: col.Name,
-				Type: col.Type.Name(),
-			})
-	}
-
 	for row := range b.GetRowsChan() {
 		if len(row) == 0 {
 			continue
 		}
+		if options.Int64AsString {
+			for colName, value := range row {
+				row[colName] = toJSONValue(value, options)
+			}
+		}
 		res.RowBasedData = append(res.RowBasedData, row)
 	}
 
 	return res
 }
 
+// NewJSONColBow returns a new JSONColBow structure from a Bow, with the values encoded following `options`.
+func NewJSONColBow(b Bow, options JSONOptions) JSONColBow {
+	if b == nil {
+		return JSONColBow{}
+	}
+
+	res := JSONColBow{
+		Schema:  newJSONSchema(b),
+		NumRows: b.NumRows(),
+		Columns: make([]JSONColumn, b.NumCols()),
+	}
+
+	for colIndex := range res.Columns {
+		col := JSONColumn{
+			Name:     b.ColumnName(colIndex),
+			Data:     make([]interface{}, b.NumRows()),
+			Validity: make([]bool, b.NumRows()),
+		}
+		for rowIndex := range col.Data {
+			value := b.GetValue(colIndex, rowIndex)
+			col.Data[rowIndex] = toJSONValue(value, options)
+			col.Validity[rowIndex] = value != nil
+		}
+		res.Columns[colIndex] = col
+	}
+
+	return res
+}
+
+func newJSONSchema(b Bow) JSONSchema {
+	var res JSONSchema
+	for colIndex, col := range b.Schema().Fields() {
+		res.Fields = append(
+			res.Fields,
+			jsonField{
+				Name: col.Name,
+				Type: getJSONFieldType(b.ColumnType(colIndex), col.Type),
+			})
+	}
+	for keyIndex, key := range b.Metadata().Keys() {
+		res.Metadata = append(res.Metadata,
+			jsonKeyValue{Key: key, Value: b.Metadata().Values()[keyIndex]})
+	}
+	return res
+}
+
+// getJSONFieldType returns the name of the Type `typ` of a field of arrow type `dataType`,
+// being the arrow type name including the time zone for the timestamp types with a time zone.
+func getJSONFieldType(typ Type, dataType arrow.DataType) string {
+	if timestampType, ok := dataType.(*arrow.TimestampType); ok && timestampType.TimeZone != "" {
+		return timestampType.String()
+	}
+	return typ.String()
+}
+
+// parseJSONFieldType returns the Type and the time zone of a JSON field type,
+// the timestamp types being written with their time zone by getJSONFieldType.
+func parseJSONFieldType(fieldType string) (Type, string) {
+	if strings.HasPrefix(fieldType, "timestamp[") && strings.HasSuffix(fieldType, "]") {
+		if unit, timeZone, ok := strings.Cut(fieldType[:len(fieldType)-1], ", tz="); ok {
+			return getBowTypeFromArrowString(unit + "]"), timeZone
+		}
+	}
+	return getBowTypeFromArrowString(fieldType), ""
+}
+
+// newJSONSeries returns a new Series from the Buffer `buf` decoded from JSON, with the time zone `timeZone` if any.
+func newJSONSeries(name string, buf Buffer, timeZone string) (Series, error) {
+	s := NewSeriesFromBuffer(name, buf)
+	if timeZone == "" {
+		return s, nil
+	}
+	return s.WithTimeZone(timeZone)
+}
+
+func toJSONValue(value interface{}, options JSONOptions) interface{} {
+	if !options.Int64AsString {
+		return value
+	}
+
+	switch v := value.(type) {
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case arrow.Timestamp:
+		return strconv.FormatInt(int64(v), 10)
+	case arrow.Duration:
+		return strconv.FormatInt(int64(v), 10)
+	}
+	return value
+}
+
 // UnmarshalJSON parses the JSON-encoded data and stores the result in the bow.
+// Both the JSONBow and JSONColBow encodings are supported, the latter being detected by its `columns` key.
 func (b *bow) UnmarshalJSON(data []byte) error {
+	return b.UnmarshalJSONWithOptions(data, JSONOptions{})
+}
+
+// UnmarshalJSONWithOptions parses the JSON-encoded data with the JSONOptions `options`
+// and stores the result in the bow.
+// Both the JSONBow and JSONColBow encodings are supported, the latter being detected by its `columns` key.
+func (b *bow) UnmarshalJSONWithOptions(data []byte, options JSONOptions) error {
+	var colBased struct {
+		Columns json.RawMessage `json:"columns"`
+	}
+	if err := json.Unmarshal(data, &colBased); err != nil {
+		return fmt.Errorf("json.Unmarshal: %w", err)
+	}
+
+	if colBased.Columns != nil {
+		// numbers are decoded as json.Number to keep the precision of 64 bits integers
+		jsonB := JSONColBow{}
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(&jsonB); err != nil {
+			return fmt.Errorf("json.Decoder.Decode: %w", err)
+		}
+
+		if err := b.NewValuesFromJSONColBow(jsonB, options); err != nil {
+			return fmt.Errorf("bow.NewValuesFromJSONColBow: %w", err)
+		}
+
+		return nil
+	}
+
 	jsonB := JSONBow{}
 	if err := json.Unmarshal(data, &jsonB); err != nil {
 		return fmt.Errorf("json.Unmarshal: %w", err)
 	}
 
-	if err := b.NewValuesFromJSON(jsonB); err != nil {
+	if err := b.NewValuesFromJSONWithOptions(jsonB, options); err != nil {
 		return fmt.Errorf("bow.NewValuesFromJSON: %w", err)
 	}
 
 	return nil
-
 }
 
 // NewValuesFromJSON replaces the bow arrow.Record by a new one represented by the JSONBow structure.
 func (b *bow) NewValuesFromJSON(jsonB JSONBow) error {
+	return b.NewValuesFromJSONWithOptions(jsonB, JSONOptions{})
+}
+
+// NewValuesFromJSONWithOptions replaces the bow arrow.Record by a new one represented by the JSONBow structure,
+// decoded with the JSONOptions `options`.
+func (b *bow) NewValuesFromJSONWithOptions(jsonB JSONBow, options JSONOptions) error {
 	if len(jsonB.Schema.Fields) == 0 {
 		b.Record = NewBowEmpty().(*bow).Record
 		return nil
@@ -95,7 +276,7 @@ func (b *bow) NewValuesFromJSON(jsonB JSONBow) error {
 	*/
 
 	for fieldIndex, field := range jsonB.Schema.Fields {
-		if _, ok := mapArrowNameToBowTypes[field.Type]; ok {
+		if _, ok := mapArrowStringToBowTypes[field.Type]; ok {
 			continue
 		}
 		switch field.Type {
@@ -108,35 +289,90 @@ func (b *bow) NewValuesFromJSON(jsonB JSONBow) error {
 		}
 	}
 
-	series := make([]Series, len(jsonB.Schema.Fields))
+	types := make([]Type, len(jsonB.Schema.Fields))
+	timeZones := make([]string, len(jsonB.Schema.Fields))
+	isField := make(map[string]bool, len(jsonB.Schema.Fields))
+	for fieldIndex, field := range jsonB.Schema.Fields {
+		types[fieldIndex], timeZones[fieldIndex] = parseJSONFieldType(field.Type)
+		if !types[fieldIndex].IsSupported() {
+			return fmt.Errorf("unsupported type '%s' for column '%s'", field.Type, field.Name)
+		}
+		isField[field.Name] = true
+	}
 
-	if jsonB.RowBasedData == nil {
-		for fieldIndex, field := range jsonB.Schema.Fields {
-			typ := getBowTypeFromArrowName(field.Type)
-			buf := NewBuffer(0, typ)
-			series[fieldIndex] = NewSeriesFromBuffer(field.Name, buf)
+	if options.Strict {
+		for rowIndex, row := range jsonB.RowBasedData {
+			for key := range row {
+				if !isField[key] {
+					return fmt.Errorf("row %d: column '%s' not found in schema", rowIndex, key)
+				}
+			}
 		}
+	}
 
-		tmpBow, err := NewBow(series...)
-		if err != nil {
-			return err
+	series := make([]Series, len(jsonB.Schema.Fields))
+	for fieldIndex, field := range jsonB.Schema.Fields {
+		buf := NewBuffer(len(jsonB.RowBasedData), types[fieldIndex])
+		for rowIndex, row := range jsonB.RowBasedData {
+			if err := setJSONValue(&buf, rowIndex, row[field.Name], options.Strict); err != nil {
+				return fmt.Errorf("row %d: column '%s': %w", rowIndex, field.Name, err)
+			}
 		}
 
-		b.Record = tmpBow.(*bow).Record
-		return nil
+		var err error
+		if series[fieldIndex], err = newJSONSeries(field.Name, buf, timeZones[fieldIndex]); err != nil {
+			return fmt.Errorf("column '%s': %w", field.Name, err)
+		}
 	}
 
+	tmpBow, err := NewBowWithMetadata(newMetadataFromJSONSchema(jsonB.Schema), series...)
+	if err != nil {
+		return err
+	}
+
+	b.Record = tmpBow.(*bow).Record
+	return nil
+}
+
+// NewValuesFromJSONColBow replaces the bow arrow.Record by a new one represented by the JSONColBow structure,
+// decoded with the JSONOptions `options`.
+func (b *bow) NewValuesFromJSONColBow(jsonB JSONColBow, options JSONOptions) error {
+	if len(jsonB.Schema.Fields) != len(jsonB.Columns) {
+		return fmt.Errorf("found %d columns for %d fields", len(jsonB.Columns), len(jsonB.Schema.Fields))
+	}
+
+	series := make([]Series, len(jsonB.Schema.Fields))
 	for fieldIndex, field := range jsonB.Schema.Fields {
-		typ := getBowTypeFromArrowName(field.Type)
-		buf := NewBuffer(len(jsonB.RowBasedData), typ)
-		for rowIndex, row := range jsonB.RowBasedData {
-			buf.SetOrDrop(rowIndex, row[field.Name])
+		typ, timeZone := parseJSONFieldType(field.Type)
+		if !typ.IsSupported() {
+			return fmt.Errorf("unsupported type '%s' for column '%s'", field.Type, field.Name)
+		}
+
+		col := jsonB.Columns[fieldIndex]
+		if options.Strict && col.Name != field.Name {
+			return fmt.Errorf("column %d: name '%s' does not match field '%s'", fieldIndex, col.Name, field.Name)
+		}
+		if len(col.Data) != jsonB.NumRows || (col.Validity != nil && len(col.Validity) != jsonB.NumRows) {
+			return fmt.Errorf("column '%s' has not %d values", field.Name, jsonB.NumRows)
+		}
+
+		buf := NewBuffer(jsonB.NumRows, typ)
+		for rowIndex, value := range col.Data {
+			if col.Validity != nil && !col.Validity[rowIndex] {
+				continue
+			}
+			if err := setJSONValue(&buf, rowIndex, value, options.Strict); err != nil {
+				return fmt.Errorf("row %d: column '%s': %w", rowIndex, field.Name, err)
+			}
 		}
 
-		series[fieldIndex] = NewSeriesFromBuffer(field.Name, buf)
+		var err error
+		if series[fieldIndex], err = newJSONSeries(field.Name, buf, timeZone); err != nil {
+			return fmt.Errorf("column '%s': %w", field.Name, err)
+		}
 	}
 
-	tmpBow, err := NewBow(series...)
+	tmpBow, err := NewBowWithMetadata(newMetadataFromJSONSchema(jsonB.Schema), series...)
 	if err != nil {
 		return err
 	}
@@ -144,3 +380,79 @@ func (b *bow) NewValuesFromJSON(jsonB JSONBow) error {
 	b.Record = tmpBow.(*bow).Record
 	return nil
 }
+
+func newMetadataFromJSONSchema(schema JSONSchema) Metadata {
+	var keys, values []string
+	for _, m := range schema.Metadata {
+		keys = append(keys, m.Key)
+		values = append(values, m.Value)
+	}
+	return NewMetadata(keys, values)
+}
+
+// setJSONValue sets the decoded JSON `value` at `rowIndex` in `buf`.
+// If it cannot be converted to the buffer Type, the value is set to nil,
+// or an error is returned if `strict` is true.
+func setJSONValue(buf *Buffer, rowIndex int, value interface{}, strict bool) error {
+	if strict && value != nil && (!isJSONValueOfType(value, buf.DataType) || buf.DataType.Convert(value) == nil) {
+		return fmt.Errorf("value '%v' does not match type '%s'", value, buf.DataType)
+	}
+
+	// Binary values are encoded as base64 strings by encoding/json
+	if str, ok := value.(string); ok && buf.DataType == Binary {
+		decoded, err := base64.StdEncoding.DecodeString(str)
+		if err != nil {
+			return fmt.Errorf("base64.StdEncoding.DecodeString: %w", err)
+		}
+		buf.SetOrDropStrict(rowIndex, decoded)
+		return nil
+	}
+	buf.SetOrDrop(rowIndex, value)
+	return nil
+}
+
+// isJSONValueOfType returns whether the kind of the decoded JSON `value` matches the Type `typ`,
+// 64 bits integers, timestamps, dates, durations and decimals being also accepted as strings.
+func isJSONValueOfType(value interface{}, typ Type) bool {
+	_, isString := value.(string)
+	switch typ {
+	case Boolean:
+		_, ok := value.(bool)
+		return ok
+	case String, Dictionary, Binary:
+		return isString
+	case List:
+		_, ok := value.([]interface{})
+		return ok
+	case Struct:
+		_, ok := value.(map[string]interface{})
+		return ok
+	case Float64, Float32:
+		return isJSONNumber(value)
+	case Int8, Int16, Int32, Uint8, Uint16, Uint32:
+		return isJSONInteger(value)
+	case Int64, Uint64, TimestampSec, TimestampMilli, TimestampMicro, TimestampNano, Date, Duration:
+		return isJSONInteger(value) || isString
+	case Decimal:
+		return isJSONNumber(value) || isString
+	}
+	return false
+}
+
+func isJSONNumber(value interface{}) bool {
+	switch value.(type) {
+	case float64, json.Number:
+		return true
+	}
+	return false
+}
+
+func isJSONInteger(value interface{}) bool {
+	switch v := value.(type) {
+	case float64:
+		return v == math.Trunc(v)
+	case json.Number:
+		return !strings.ContainsAny(v.String(), ".eE")
+	}
+	return false
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -101,6 +102,70 @@ func TestJSON(t *testing.T) {
 				fmt.Sprintf("have:\n%vexpect:\n%v", bCopy, b))
 		})
 
+		t.Run("timestamp", func(t *testing.T) {
+			b, err := NewBowFromRowBasedInterfaces(
+				[]string{"time", "value"},
+				[]Type{TimestampNano, Float64},
+				[][]interface{}{
+					{1, 200.},
+					{nil, 220.},
+				})
+			require.NoError(t, err)
+
+			byteB, err := json.Marshal(b)
+			require.NoError(t, err)
+
+			res := NewBowEmpty()
+			err = json.Unmarshal(byteB, res)
+			require.NoError(t, err)
+
+			assert.True(t, b.Equal(res),
+				fmt.Sprintf("have:\n%vexpect:\n%v", res, b))
+		})
+
+		t.Run("decimal", func(t *testing.T) {
+			b, err := NewBowFromRowBasedInterfaces(
+				[]string{"energy"},
+				[]Type{Decimal},
+				[][]interface{}{
+					{"12345678901234567890123456789.123456789"},
+					{nil},
+				})
+			require.NoError(t, err)
+
+			byteB, err := json.Marshal(b)
+			require.NoError(t, err)
+
+			res := NewBowEmpty()
+			err = json.Unmarshal(byteB, res)
+			require.NoError(t, err)
+
+			assert.True(t, b.Equal(res),
+				fmt.Sprintf("have:\n%vexpect:\n%v", res, b))
+		})
+
+		t.Run("binary", func(t *testing.T) {
+			b, err := NewBowFromRowBasedInterfaces(
+				[]string{"hash"},
+				[]Type{Binary},
+				[][]interface{}{
+					{[]byte{0x00, 0xff, 0x10}},
+					{nil},
+				})
+			require.NoError(t, err)
+
+			byteB, err := json.Marshal(b)
+			require.NoError(t, err)
+			assert.Contains(t, string(byteB), `"AP8Q"`)
+
+			res := NewBowEmpty()
+			err = json.Unmarshal(byteB, res)
+			require.NoError(t, err)
+
+			assert.True(t, b.Equal(res),
+				fmt.Sprintf("have:\n%vexpect:\n%v", res, b))
+		})
+
 		t.Run("simple no data", func(t *testing.T) {
 			b, err := NewBowFromRowBasedInterfaces(
 				[]string{"a", "b", "c"},
@@ -121,6 +186,192 @@ func TestJSON(t *testing.T) {
 	})
 }
 
+func TestJSONWithOptions(t *testing.T) {
+	b, err := NewBowFromRowBasedInterfaces(
+		[]string{"int", "float", "string", "time", "binary"},
+		[]Type{Int64, Float64, String, TimestampMilli, Binary},
+		[][]interface{}{
+			{int64(9007199254740993), 1., "a", 1, []byte("x")},
+			{nil, nil, nil, nil, nil},
+			{3, 3., "c", 3, []byte{}},
+		})
+	require.NoError(t, err)
+	b = b.SetMetadata("source", "test").SetMetadata("unit", "kWh")
+
+	t.Run("col based", func(t *testing.T) {
+		byteB, err := b.MarshalJSONWithOptions(JSONOptions{ColBased: true, Int64AsString: true})
+		require.NoError(t, err)
+
+		jsonB := JSONColBow{}
+		require.NoError(t, json.Unmarshal(byteB, &jsonB))
+
+		expected := JSONColBow{
+			Schema: JSONSchema{
+				Fields: []jsonField{
+					{Name: "int", Type: "int64"},
+					{Name: "float", Type: "float64"},
+					{Name: "string", Type: "utf8"},
+					{Name: "time", Type: "timestamp[ms]"},
+					{Name: "binary", Type: "binary"},
+				},
+				Metadata: []jsonKeyValue{
+					{Key: "source", Value: "test"},
+					{Key: "unit", Value: "kWh"},
+				},
+			},
+			NumRows: 3,
+			Columns: []JSONColumn{
+				{Name: "int", Data: []interface{}{"9007199254740993", nil, "3"}, Validity: []bool{true, false, true}},
+				{Name: "float", Data: []interface{}{1., nil, 3.}, Validity: []bool{true, false, true}},
+				{Name: "string", Data: []interface{}{"a", nil, "c"}, Validity: []bool{true, false, true}},
+				{Name: "time", Data: []interface{}{"1", nil, "3"}, Validity: []bool{true, false, true}},
+				{Name: "binary", Data: []interface{}{"eA==", nil, ""}, Validity: []bool{true, false, true}},
+			},
+		}
+		assert.Equal(t, expected, jsonB)
+
+		res := NewBowEmpty()
+		require.NoError(t, json.Unmarshal(byteB, res))
+		ExpectEqual(t, b, res)
+	})
+
+	t.Run("col based with int64 as numbers keeps their precision", func(t *testing.T) {
+		byteB, err := b.MarshalJSONWithOptions(JSONOptions{ColBased: true})
+		require.NoError(t, err)
+
+		res := NewBowEmpty()
+		require.NoError(t, json.Unmarshal(byteB, res))
+		ExpectEqual(t, b, res)
+	})
+
+	t.Run("row based with int64 as strings", func(t *testing.T) {
+		byteB, err := b.MarshalJSONWithOptions(JSONOptions{Int64AsString: true})
+		require.NoError(t, err)
+
+		jsonB := JSONBow{}
+		require.NoError(t, json.Unmarshal(byteB, &jsonB))
+		assert.Equal(t, "9007199254740993", jsonB.RowBasedData[0]["int"])
+		assert.Equal(t, "1", jsonB.RowBasedData[0]["time"])
+
+		res := NewBowEmpty()
+		require.NoError(t, json.Unmarshal(byteB, res))
+		assert.Equal(t, int64(9007199254740993), res.GetValue(0, 0))
+	})
+
+	t.Run("invalid col based", func(t *testing.T) {
+		res := NewBowEmpty()
+		assert.Error(t, json.Unmarshal([]byte(`{"schema": {"fields": [{"name": "a", "type": "int64"}]},
+			"num_rows": 2, "columns": [{"name": "a", "data": [1], "validity": [true]}]}`), res))
+		assert.Error(t, json.Unmarshal([]byte(`{"schema": {"fields": [{"name": "a", "type": "int64"}]},
+			"num_rows": 0, "columns": []}`), res))
+	})
+
+	t.Run("row based keeps metadata", func(t *testing.T) {
+		byteB, err := b.MarshalJSON()
+		require.NoError(t, err)
+
+		jsonB := JSONBow{}
+		require.NoError(t, json.Unmarshal(byteB, &jsonB))
+		assert.Equal(t, []jsonKeyValue{
+			{Key: "source", Value: "test"},
+			{Key: "unit", Value: "kWh"},
+		}, jsonB.Schema.Metadata)
+
+		res := NewBowEmpty()
+		require.NoError(t, json.Unmarshal(byteB, res))
+		assert.Equal(t, b.Metadata().String(), res.Metadata().String())
+	})
+
+	t.Run("timestamps with time zone", func(t *testing.T) {
+		timeSeries, err := NewSeries("time", TimestampSec, []arrow.Timestamp{1, 2}, []bool{true, false}).
+			WithTimeZone("Europe/Paris")
+		require.NoError(t, err)
+		b, err := NewBow(timeSeries)
+		require.NoError(t, err)
+
+		for _, options := range []JSONOptions{{}, {ColBased: true}} {
+			byteB, err := b.MarshalJSONWithOptions(options)
+			require.NoError(t, err)
+			assert.Contains(t, string(byteB), `"type":"timestamp[s, tz=Europe/Paris]"`)
+
+			res := NewBowEmpty()
+			require.NoError(t, json.Unmarshal(byteB, res))
+			ExpectEqual(t, b, res)
+			assert.Equal(t, b.Schema(), res.Schema())
+		}
+
+		res := NewBowEmpty()
+		assert.Error(t, json.Unmarshal([]byte(`{"schema": {"fields": [{"name": "a", "type": "timestamp[s, tz=Not/AZone]"}]},
+			"data": []}`), res))
+	})
+}
+
+func TestJSONStrict(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		res := NewBowEmpty()
+		require.NoError(t, res.UnmarshalJSONWithOptions([]byte(`{"schema": {"fields": [
+			{"name": "a", "type": "int64"}, {"name": "b", "type": "float64"}, {"name": "c", "type": "timestamp[ms]"}]},
+			"data": [{"a": 1, "b": 1.5, "c": "2"}, {"a": null, "b": 2}]}`), JSONOptions{Strict: true}))
+
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"a", "b", "c"},
+			[]Type{Int64, Float64, TimestampMilli},
+			[][]interface{}{
+				{1, 1.5, 2},
+				{nil, 2., nil},
+			})
+		require.NoError(t, err)
+		ExpectEqual(t, expected, res)
+	})
+
+	t.Run("not strict writes nulls", func(t *testing.T) {
+		res := NewBowEmpty()
+		require.NoError(t, res.UnmarshalJSON([]byte(`{"schema": {"fields": [{"name": "a", "type": "bool"}]},
+			"data": [{"a": true}, {"a": "maybe"}]}`)))
+		assert.Equal(t, true, res.GetValue(0, 0))
+		assert.Nil(t, res.GetValue(0, 1))
+	})
+
+	for name, testCase := range map[string]struct {
+		data string
+		err  string
+	}{
+		"row based type mismatch": {
+			data: `{"schema": {"fields": [{"name": "a", "type": "bool"}]}, "data": [{"a": true}, {"a": "maybe"}]}`,
+			err:  "row 1: column 'a': value 'maybe' does not match type 'bool'",
+		},
+		"row based float for int": {
+			data: `{"schema": {"fields": [{"name": "a", "type": "int64"}]}, "data": [{"a": 1.5}]}`,
+			err:  "row 0: column 'a': value '1.5' does not match type 'int64'",
+		},
+		"row based unknown key": {
+			data: `{"schema": {"fields": [{"name": "a", "type": "int64"}]}, "data": [{"a": 1}, {"b": 1}]}`,
+			err:  "row 1: column 'b' not found in schema",
+		},
+		"col based type mismatch": {
+			data: `{"schema": {"fields": [{"name": "a", "type": "float64"}]}, "num_rows": 2,
+				"columns": [{"name": "a", "data": [1, "x"], "validity": [true, true]}]}`,
+			err: "row 1: column 'a': value 'x' does not match type 'float64'",
+		},
+		"col based invalid base64": {
+			data: `{"schema": {"fields": [{"name": "a", "type": "binary"}]}, "num_rows": 1,
+				"columns": [{"name": "a", "data": ["!"]}]}`,
+			err: "row 0: column 'a': base64.StdEncoding.DecodeString",
+		},
+		"col based name mismatch": {
+			data: `{"schema": {"fields": [{"name": "a", "type": "float64"}]}, "num_rows": 0,
+				"columns": [{"name": "b", "data": []}]}`,
+			err: "column 0: name 'b' does not match field 'a'",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := NewBowEmpty().UnmarshalJSONWithOptions([]byte(testCase.data), JSONOptions{Strict: true})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), testCase.err)
+		})
+	}
+}
+
 func BenchmarkBow_JSON(b *testing.B) {
 	for rows := 10; rows <= 100000; rows *= 10 {
 		data, err := NewBowFromParquet(fmt.Sprintf(
@@ -0,0 +1,380 @@
+package bow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+const ndjsonDefaultBatchSize = 1 << 14
+
+// NDJSONOptions are options to read newline-delimited JSON data, made of one JSON object per line:
+// - ColNames: names of the columns to read, other keys being ignored,
+// defaults to the keys of the first batch in their order of appearance
+// - ColTypes: types of the columns named ColNames, Unknown types being inferred from the values, see NDJSONReader
+// - BatchSize: number of rows of the Bows returned by NDJSONReader.Next, defaults to ndjsonDefaultBatchSize
+// - Strict: returns an error for the values which do not match the type of their column, instead of setting nil values.
+// Values which cannot be converted to the type inferred for their column always return an error,
+// as do the inferred columns mixing JSON kinds, such as numbers and booleans or numbers and strings.
+type NDJSONOptions struct {
+	ColNames  []string
+	ColTypes  []Type
+	BatchSize int
+	Strict    bool
+}
+
+func (o *NDJSONOptions) validate() error {
+	if o.ColTypes != nil && len(o.ColTypes) != len(o.ColNames) {
+		return fmt.Errorf("found %d column types for %d column names", len(o.ColTypes), len(o.ColNames))
+	}
+	if o.BatchSize <= 0 {
+		o.BatchSize = ndjsonDefaultBatchSize
+	}
+	return nil
+}
+
+// NDJSONReader reads newline-delimited JSON data batch by batch, building the Buffers of each Bow incrementally.
+// The schema is inferred from the first batch if not provided, the keys only found in later batches being ignored.
+// The inferred types are updated by the later batches: the columns without any value so far are inferred again,
+// and Int64 columns are widened to Float64 on non-integer numbers, so the Bows of a NDJSONReader may have different types.
+// Binary values are expected to be encoded as base64 strings, as written by Bow.WriteNDJSON.
+type NDJSONReader struct {
+	decoder  *json.Decoder
+	options  NDJSONOptions
+	colNames []string
+	colTypes []Type
+	// isInferred and hasValues tell for each column if its type is inferred, and if it had a value so far
+	isInferred []bool
+	hasValues  []bool
+	numRows    int
+}
+
+// NewNDJSONReader returns a new NDJSONReader reading from `r` with the NDJSONOptions `options`.
+func NewNDJSONReader(r io.Reader, options NDJSONOptions) (*NDJSONReader, error) {
+	if err := options.validate(); err != nil {
+		return nil, err
+	}
+
+	return &NDJSONReader{
+		decoder:  json.NewDecoder(r),
+		options:  options,
+		colNames: options.ColNames,
+	}, nil
+}
+
+// HasNext returns true if the next call to Next will return a new Bow.
+func (r *NDJSONReader) HasNext() bool {
+	return r.decoder.More()
+}
+
+// Next returns a new Bow holding the next BatchSize rows.
+// Returns io.EOF if all the rows have been read.
+func (r *NDJSONReader) Next() (Bow, error) {
+	if !r.HasNext() {
+		return nil, io.EOF
+	}
+
+	var rows []ndjsonRow
+	for len(rows) < r.options.BatchSize && r.decoder.More() {
+		var raw json.RawMessage
+		if err := r.decoder.Decode(&raw); err != nil {
+			return nil, fmt.Errorf("row %d: json.Decoder.Decode: %w", r.numRows+len(rows), err)
+		}
+		row, err := decodeNDJSONRow(raw)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", r.numRows+len(rows), err)
+		}
+		rows = append(rows, row)
+	}
+
+	if r.colTypes == nil {
+		r.inferSchema(rows)
+	}
+	if err := r.updateInferredTypes(rows); err != nil {
+		return nil, err
+	}
+
+	series := make([]Series, len(r.colNames))
+	for colIndex, colName := range r.colNames {
+		buf := NewBuffer(len(rows), r.colTypes[colIndex])
+		for rowIndex, row := range rows {
+			value := row.values[colName]
+			if err := setJSONValue(&buf, rowIndex, value, r.options.Strict); err != nil {
+				return nil, fmt.Errorf("row %d: column '%s': %w", r.numRows+rowIndex, colName, err)
+			}
+			if value != nil && r.isInferred[colIndex] && (!isJSONValueOfType(value, buf.DataType) || buf.IsNull(rowIndex)) {
+				return nil, fmt.Errorf("row %d: column '%s': value '%v' does not match inferred type '%s'",
+					r.numRows+rowIndex, colName, value, buf.DataType)
+			}
+		}
+		series[colIndex] = NewSeriesFromBuffer(colName, buf)
+	}
+	r.numRows += len(rows)
+
+	return NewBow(series...)
+}
+
+// inferSchema sets the column names if missing and the given column types from the first batch of rows.
+func (r *NDJSONReader) inferSchema(rows []ndjsonRow) {
+	if r.colNames == nil {
+		seen := make(map[string]bool)
+		for _, row := range rows {
+			for _, key := range row.keys {
+				if !seen[key] {
+					seen[key] = true
+					r.colNames = append(r.colNames, key)
+				}
+			}
+		}
+	}
+
+	r.colTypes = make([]Type, len(r.colNames))
+	r.isInferred = make([]bool, len(r.colNames))
+	r.hasValues = make([]bool, len(r.colNames))
+	for colIndex := range r.colNames {
+		if r.options.ColTypes != nil && r.options.ColTypes[colIndex] != Unknown {
+			r.colTypes[colIndex] = r.options.ColTypes[colIndex]
+		} else {
+			r.isInferred[colIndex] = true
+		}
+	}
+}
+
+// updateInferredTypes infers the types of the inferred columns without any value so far from a batch of rows,
+// and widens the inferred Int64 columns to Float64 if the batch holds non-integer numbers.
+// Returns an error if the values of an inferred column are of different JSON kinds, such as numbers and booleans.
+func (r *NDJSONReader) updateInferredTypes(rows []ndjsonRow) error {
+	for colIndex, colName := range r.colNames {
+		if !r.isInferred[colIndex] {
+			continue
+		}
+
+		var kind string
+		if r.hasValues[colIndex] {
+			kind = getNDJSONTypeKind(r.colTypes[colIndex])
+		}
+		values := make([]interface{}, len(rows))
+		var hasValues bool
+		for rowIndex, row := range rows {
+			values[rowIndex] = row.values[colName]
+			if values[rowIndex] == nil {
+				continue
+			}
+			hasValues = true
+			valueKind := getNDJSONValueKind(values[rowIndex])
+			if kind == "" {
+				kind = valueKind
+			} else if valueKind != kind {
+				return fmt.Errorf("row %d: column '%s': %s value '%v' does not match the %s values of the column",
+					r.numRows+rowIndex, colName, valueKind, values[rowIndex], kind)
+			}
+		}
+		if r.hasValues[colIndex] && (r.colTypes[colIndex] != Int64 || !hasValues) {
+			continue
+		}
+
+		typ, err := getBowTypeFromNDJSONValues(values)
+		if err != nil {
+			return fmt.Errorf("column '%s': %w", colName, err)
+		}
+		if !r.hasValues[colIndex] || typ == Float64 {
+			r.colTypes[colIndex] = typ
+		}
+		r.hasValues[colIndex] = r.hasValues[colIndex] || hasValues
+	}
+
+	return nil
+}
+
+// getNDJSONValueKind returns the JSON kind of a decoded JSON value.
+func getNDJSONValueKind(value interface{}) string {
+	switch value.(type) {
+	case json.Number:
+		return "number"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []interface{}:
+		return "array"
+	case map[string]interface{}:
+		return "object"
+	}
+	return "unknown"
+}
+
+// getNDJSONTypeKind returns the JSON kind of the values from which the Type `typ` is inferred.
+func getNDJSONTypeKind(typ Type) string {
+	switch typ {
+	case Int64, Float64:
+		return "number"
+	case Boolean:
+		return "boolean"
+	case String:
+		return "string"
+	case List:
+		return "array"
+	case Struct:
+		return "object"
+	}
+	return "unknown"
+}
+
+// ndjsonRow is a decoded JSON object, with its keys in their order of appearance.
+type ndjsonRow struct {
+	values map[string]interface{}
+	keys   []string
+}
+
+func decodeNDJSONRow(raw json.RawMessage) (ndjsonRow, error) {
+	// numbers are decoded as json.Number to keep the precision of 64 bits integers
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+
+	if token, err := decoder.Token(); err != nil || token != json.Delim('{') {
+		return ndjsonRow{}, fmt.Errorf("value is not a JSON object")
+	}
+
+	row := ndjsonRow{values: make(map[string]interface{})}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return ndjsonRow{}, fmt.Errorf("json.Decoder.Token: %w", err)
+		}
+		key := token.(string)
+
+		var value interface{}
+		if err = decoder.Decode(&value); err != nil {
+			return ndjsonRow{}, fmt.Errorf("json.Decoder.Decode: %w", err)
+		}
+
+		if _, ok := row.values[key]; !ok {
+			row.keys = append(row.keys, key)
+		}
+		row.values[key] = value
+	}
+
+	return row, nil
+}
+
+// getBowTypeFromNDJSONValues infers the Type of a column from its decoded JSON values.
+// Numbers are Int64 if all of them are integers, Float64 otherwise, numbers nested in lists and objects being Float64.
+func getBowTypeFromNDJSONValues(values []interface{}) (Type, error) {
+	var hasNumber, hasFloat bool
+	for _, value := range values {
+		if number, ok := value.(json.Number); ok {
+			hasNumber = true
+			if _, err := number.Int64(); err != nil {
+				hasFloat = true
+			}
+		}
+	}
+
+	switch {
+	case hasFloat:
+		return Float64, nil
+	case hasNumber:
+		return Int64, nil
+	}
+
+	typ, err := getBowTypeFromInterfaces(values)
+	if err != nil {
+		return Unknown, err
+	}
+	if !typ.IsSupported() {
+		return Unknown, fmt.Errorf("unsupported type '%s'", typ)
+	}
+	return typ, nil
+}
+
+// NewBowFromNDJSON returns a new Bow from all the newline-delimited JSON data read from `r`.
+// See NDJSONReader for the streaming version.
+func NewBowFromNDJSON(r io.Reader, options NDJSONOptions) (Bow, error) {
+	reader, err := NewNDJSONReader(r, options)
+	if err != nil {
+		return nil, err
+	}
+
+	var bows []Bow
+	for reader.HasNext() {
+		b, err := reader.Next()
+		if err != nil {
+			return nil, err
+		}
+		bows = append(bows, b)
+	}
+
+	// The first Bows are converted to the types updated by the later batches
+	for i, b := range bows {
+		for colIndex, typ := range reader.colTypes {
+			if b.ColumnType(colIndex) == typ {
+				continue
+			}
+			if bows[i], err = bows[i].Convert(colIndex, typ); err != nil {
+				return nil, fmt.Errorf("bow.Convert: %w", err)
+			}
+		}
+	}
+
+	if len(bows) == 0 {
+		series := make([]Series, len(options.ColNames))
+		for colIndex, colName := range options.ColNames {
+			typ := Float64
+			if options.ColTypes != nil && options.ColTypes[colIndex] != Unknown {
+				typ = options.ColTypes[colIndex]
+			}
+			series[colIndex] = NewSeriesFromBuffer(colName, NewBuffer(0, typ))
+		}
+		return NewBow(series...)
+	}
+
+	return AppendBows(bows...)
+}
+
+// WriteNDJSON writes the Bow to `w` as newline-delimited JSON, one JSON object per row, streaming the rows.
+// The keys of each object follow the column order, nil values being omitted.
+// Values are encoded as with Bow.MarshalJSON, following `options` whose option ColBased is ignored.
+func (b *bow) WriteNDJSON(w io.Writer, options JSONOptions) error {
+	keys := make([][]byte, b.NumCols())
+	for colIndex := range keys {
+		key, err := json.Marshal(b.ColumnName(colIndex))
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %w", err)
+		}
+		keys[colIndex] = key
+	}
+
+	var line bytes.Buffer
+	for rowIndex := 0; rowIndex < b.NumRows(); rowIndex++ {
+		line.Reset()
+		line.WriteByte('{')
+		first := true
+		for colIndex, key := range keys {
+			value := b.GetValue(colIndex, rowIndex)
+			if value == nil {
+				continue
+			}
+
+			encoded, err := json.Marshal(toJSONValue(value, options))
+			if err != nil {
+				return fmt.Errorf("row %d: column '%s': json.Marshal: %w", rowIndex, b.ColumnName(colIndex), err)
+			}
+
+			if !first {
+				line.WriteByte(',')
+			}
+			first = false
+			line.Write(key)
+			line.WriteByte(':')
+			line.Write(encoded)
+		}
+		line.WriteString("}\n")
+
+		if _, err := w.Write(line.Bytes()); err != nil {
+			return fmt.Errorf("io.Writer.Write: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package bow
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNDJSON(t *testing.T) {
+	t.Run("read with inferred schema", func(t *testing.T) {
+		input := `{"time": 1, "value": 1.5, "host": "a", "ok": true}
+{"time": 9007199254740993, "host": "b"}
+
+{"value": 3, "ok": false, "extra": [1, 2]}
+`
+		res, err := NewBowFromNDJSON(strings.NewReader(input), NDJSONOptions{})
+		require.NoError(t, err)
+
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"time", "value", "host", "ok", "extra"},
+			[]Type{Int64, Float64, String, Boolean, List},
+			[][]interface{}{
+				{1, 1.5, "a", true, nil},
+				{int64(9007199254740993), nil, "b", nil, nil},
+				{nil, 3., nil, false, []interface{}{1., 2.}},
+			})
+		require.NoError(t, err)
+		assert.Equal(t, expected.Schema().String(), res.Schema().String())
+		// numbers nested in lists and objects are decoded as Float64
+		for colIndex := 0; colIndex < 5; colIndex++ {
+			for rowIndex := 0; rowIndex < 3; rowIndex++ {
+				assert.Equal(t, expected.GetValue(colIndex, rowIndex), res.GetValue(colIndex, rowIndex))
+			}
+		}
+	})
+
+	t.Run("read in batches with provided schema", func(t *testing.T) {
+		input := `{"a": 1, "b": "x", "c": 0}
+{"a": 2, "b": "y"}
+{"a": 3, "b": "z"}
+`
+		r, err := NewNDJSONReader(strings.NewReader(input), NDJSONOptions{
+			ColNames:  []string{"b", "a"},
+			ColTypes:  []Type{Dictionary, Unknown},
+			BatchSize: 2,
+		})
+		require.NoError(t, err)
+
+		var bows []Bow
+		for r.HasNext() {
+			b, err := r.Next()
+			require.NoError(t, err)
+			bows = append(bows, b)
+		}
+		_, err = r.Next()
+		assert.ErrorIs(t, err, io.EOF)
+
+		require.Len(t, bows, 2)
+		assert.Equal(t, 2, bows[0].NumRows())
+		assert.Equal(t, 1, bows[1].NumRows())
+
+		res, err := AppendBows(bows...)
+		require.NoError(t, err)
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"b", "a"},
+			[]Type{Dictionary, Int64},
+			[][]interface{}{
+				{"x", 1},
+				{"y", 2},
+				{"z", 3},
+			})
+		require.NoError(t, err)
+		ExpectEqual(t, expected, res)
+	})
+
+	t.Run("write and read back", func(t *testing.T) {
+		b, err := NewBowFromRowBasedInterfaces(
+			[]string{"int", "float", "string", "time", "binary", "decimal"},
+			[]Type{Int64, Float64, String, TimestampMilli, Binary, Decimal},
+			[][]interface{}{
+				{int64(9007199254740993), 1.5, "a", 1, []byte("x"), "0.1"},
+				{nil, nil, nil, nil, nil, nil},
+				{3, 3., "c\n", 3, []byte{}, "-2"},
+			})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, b.WriteNDJSON(&buf, JSONOptions{Int64AsString: true}))
+		assert.Equal(t, `{"int":"9007199254740993","float":1.5,"string":"a","time":"1","binary":"eA==","decimal":"0.1"}
+{}
+{"int":"3","float":3,"string":"c\n","time":"3","binary":"","decimal":"-2"}
+`, buf.String())
+
+		res, err := NewBowFromNDJSON(&buf, NDJSONOptions{
+			ColNames: []string{"int", "float", "string", "time", "binary", "decimal"},
+			ColTypes: []Type{Int64, Float64, String, TimestampMilli, Binary, Decimal},
+		})
+		require.NoError(t, err)
+		ExpectEqual(t, b, res)
+	})
+
+	t.Run("inferred types are updated by later batches", func(t *testing.T) {
+		input := `{"a": 1, "b": null}
+{"a": 1.5, "b": "x"}
+{"a": 2}
+`
+		r, err := NewNDJSONReader(strings.NewReader(input), NDJSONOptions{BatchSize: 1})
+		require.NoError(t, err)
+		var types [][]Type
+		for r.HasNext() {
+			b, err := r.Next()
+			require.NoError(t, err)
+			types = append(types, []Type{b.ColumnType(0), b.ColumnType(1)})
+		}
+		assert.Equal(t, [][]Type{{Int64, Float64}, {Float64, String}, {Float64, String}}, types)
+
+		res, err := NewBowFromNDJSON(strings.NewReader(input), NDJSONOptions{BatchSize: 1})
+		require.NoError(t, err)
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"a", "b"},
+			[]Type{Float64, String},
+			[][]interface{}{
+				{1., nil},
+				{1.5, "x"},
+				{2., nil},
+			})
+		require.NoError(t, err)
+		ExpectEqual(t, expected, res)
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		res, err := NewBowFromNDJSON(strings.NewReader(""), NDJSONOptions{
+			ColNames: []string{"a"},
+			ColTypes: []Type{Int64},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 0, res.NumRows())
+		assert.Equal(t, Int64, res.ColumnType(0))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := NewBowFromNDJSON(strings.NewReader(`[1, 2]`), NDJSONOptions{})
+		assert.Error(t, err)
+
+		_, err = NewBowFromNDJSON(strings.NewReader(`{"a": 1}
+{"a": `), NDJSONOptions{})
+		assert.Error(t, err)
+
+		_, err = NewBowFromNDJSON(strings.NewReader(`{"a": 1}`), NDJSONOptions{ColTypes: []Type{Int64}})
+		assert.Error(t, err)
+
+		// values not matching an inferred type are not dropped, even if not strict
+		_, err = NewBowFromNDJSON(strings.NewReader(`{"a": 1}
+{"a": "x"}`), NDJSONOptions{BatchSize: 1})
+		assert.Error(t, err)
+
+		// inferred columns cannot mix JSON kinds, in the same batch or across batches
+		for _, input := range []string{
+			`{"a": 1}` + "\n" + `{"a": true}`,
+			`{"a": 1}` + "\n" + `{"a": "12"}`,
+			`{"a": "x"}` + "\n" + `{"a": 1}`,
+			`{"a": true}` + "\n" + `{"a": 0}`,
+		} {
+			for _, batchSize := range []int{1, 2} {
+				_, err = NewBowFromNDJSON(strings.NewReader(input), NDJSONOptions{BatchSize: batchSize})
+				assert.Error(t, err, "%s with batch size %d", input, batchSize)
+			}
+		}
+	})
+}
@@ -0,0 +1,100 @@
+package bow
+
+import (
+	"fmt"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/bitutil"
+)
+
+// Explode returns a new Bow with one row per element of the lists of the List column `colIndex`,
+// the values of the other columns being repeated.
+// Nil and empty lists result in a single row with a nil value.
+func (b *bow) Explode(colIndex int) (Bow, error) {
+	if colIndex < 0 || colIndex >= b.NumCols() {
+		return nil, fmt.Errorf("column index out of bound")
+	}
+
+	if b.ColumnType(colIndex) != List {
+		return nil, fmt.Errorf("column '%s' of type '%s' is not a list",
+			b.ColumnName(colIndex), b.ColumnType(colIndex))
+	}
+
+	listArr := array.NewListData(b.Column(colIndex).Data())
+	var rowIndices []int
+	var elems []interface{}
+	for i := 0; i < b.NumRows(); i++ {
+		var list []interface{}
+		if listArr.IsValid(i) {
+			list = listValue(listArr, i)
+		}
+		if len(list) == 0 {
+			rowIndices = append(rowIndices, i)
+			elems = append(elems, nil)
+			continue
+		}
+		for _, elem := range list {
+			rowIndices = append(rowIndices, i)
+			elems = append(elems, elem)
+		}
+	}
+
+	series := make([]Series, b.NumCols())
+	for c := 0; c < b.NumCols(); c++ {
+		if c == colIndex {
+			elemType := getBowTypeFromArrowType(listArr.DataType().(*arrow.ListType).Elem())
+			buf := NewBuffer(len(elems), elemType)
+			for i, elem := range elems {
+				buf.SetOrDropStrict(i, elem)
+			}
+			series[c] = NewSeriesFromBuffer(b.ColumnName(c), buf)
+			continue
+		}
+
+		buf := NewBuffer(len(rowIndices), b.ColumnType(c))
+		for i, rowIndex := range rowIndices {
+			buf.SetOrDropStrict(i, b.GetValue(c, rowIndex))
+		}
+		series[c] = NewSeriesFromBuffer(b.ColumnName(c), buf)
+	}
+
+	return NewBowWithMetadata(b.Metadata(), series...)
+}
+
+// Flatten returns a new Bow with the Struct column `colIndex` replaced by one column per field,
+// named `<column name>.<field name>`. The fields of nil structs are nil.
+func (b *bow) Flatten(colIndex int) (Bow, error) {
+	if colIndex < 0 || colIndex >= b.NumCols() {
+		return nil, fmt.Errorf("column index out of bound")
+	}
+
+	if b.ColumnType(colIndex) != Struct {
+		return nil, fmt.Errorf("column '%s' of type '%s' is not a struct",
+			b.ColumnName(colIndex), b.ColumnType(colIndex))
+	}
+
+	structArr := array.NewStructData(b.Column(colIndex).Data())
+	fieldsBow := newBowFromStructFields(structArr)
+
+	var series []Series
+	for c := 0; c < b.NumCols(); c++ {
+		if c != colIndex {
+			series = append(series, b.NewSeriesFromCol(c))
+			continue
+		}
+
+		for f := 0; f < fieldsBow.NumCols(); f++ {
+			buf := fieldsBow.NewBufferFromCol(f)
+			for i := 0; i < structArr.Len(); i++ {
+				if structArr.IsNull(i) {
+					bitutil.ClearBit(buf.nullBitmapBytes, i)
+				}
+			}
+			series = append(series, NewSeriesFromBuffer(
+				fmt.Sprintf("%s.%s", b.ColumnName(c), fieldsBow.ColumnName(f)), buf))
+		}
+	}
+
+	return NewBowWithMetadata(b.Metadata(), series...)
+}
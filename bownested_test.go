@@ -0,0 +1,118 @@
+package bow
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNestedTypes(t *testing.T) {
+	b, err := NewBowFromRowBasedInterfaces(
+		[]string{"id", "readings", "payload"},
+		[]Type{Int64, List, Struct},
+		[][]interface{}{
+			{1, []interface{}{1.5, 2.5}, map[string]interface{}{"device": "a", "value": 1}},
+			{2, nil, nil},
+			{3, []float64{}, map[string]interface{}{"device": "b"}},
+			{4, []interface{}{3.5, nil}, map[string]interface{}{"value": 4}},
+		})
+	require.NoError(t, err)
+
+	t.Run("GetValue", func(t *testing.T) {
+		assert.Equal(t, []interface{}{1.5, 2.5}, b.GetValue(1, 0))
+		assert.Nil(t, b.GetValue(1, 1))
+		assert.Equal(t, []interface{}{}, b.GetValue(1, 2))
+		assert.Equal(t, []interface{}{3.5, nil}, b.GetValue(1, 3))
+
+		assert.Equal(t, map[string]interface{}{"device": "a", "value": int64(1)}, b.GetValue(2, 0))
+		assert.Nil(t, b.GetValue(2, 1))
+		assert.Equal(t, map[string]interface{}{"device": "b"}, b.GetValue(2, 2))
+	})
+
+	t.Run("Find and Filter", func(t *testing.T) {
+		assert.Equal(t, 3, b.Find(1, []interface{}{3.5, nil}))
+		assert.Equal(t, -1, b.Find(1, []interface{}{3.5}))
+
+		res := b.Filter(b.MakeFilterValues(2, map[string]interface{}{"device": "b"}))
+		ExpectEqual(t, b.NewSlice(2, 3), res)
+	})
+
+	t.Run("NewSlice", func(t *testing.T) {
+		res := b.NewSlice(2, 4)
+		assert.Equal(t, []interface{}{3.5, nil}, res.GetValue(1, 1))
+		assert.Equal(t, map[string]interface{}{"value": int64(4)}, res.GetValue(2, 1))
+	})
+
+	t.Run("Explode", func(t *testing.T) {
+		res, err := b.Explode(1)
+		require.NoError(t, err)
+
+		expect, err := NewBowFromColBasedInterfaces(
+			[]string{"id", "readings"},
+			[]Type{Int64, Float64},
+			[][]interface{}{
+				{1, 1, 2, 3, 4, 4},
+				{1.5, 2.5, nil, nil, 3.5, nil},
+			})
+		require.NoError(t, err)
+		selected, err := res.Select(0, 1)
+		require.NoError(t, err)
+		ExpectEqual(t, expect, selected)
+		assert.Equal(t, Struct, res.ColumnType(2))
+
+		_, err = b.Explode(0)
+		assert.Error(t, err)
+	})
+
+	t.Run("Flatten", func(t *testing.T) {
+		res, err := b.Flatten(2)
+		require.NoError(t, err)
+
+		expect, err := NewBowFromColBasedInterfaces(
+			[]string{"id", "payload.device", "payload.value"},
+			[]Type{Int64, String, Int64},
+			[][]interface{}{
+				{1, 2, 3, 4},
+				{"a", nil, "b", nil},
+				{1, nil, nil, 4},
+			})
+		require.NoError(t, err)
+		selected, err := res.Select(0, 2, 3)
+		require.NoError(t, err)
+		ExpectEqual(t, expect, selected)
+
+		_, err = b.Flatten(1)
+		assert.Error(t, err)
+	})
+
+	t.Run("type inference", func(t *testing.T) {
+		res, err := NewBowFromColBasedInterfaces(
+			[]string{"readings", "payload"},
+			[]Type{Unknown, Unknown},
+			[][]interface{}{
+				{nil, []int64{1, 2}},
+				{nil, map[string]float64{"value": 1.5}},
+			})
+		require.NoError(t, err)
+		assert.Equal(t, List, res.ColumnType(0))
+		assert.Equal(t, []interface{}{int64(1), int64(2)}, res.GetValue(0, 1))
+		assert.Equal(t, Struct, res.ColumnType(1))
+		assert.Equal(t, map[string]interface{}{"value": 1.5}, res.GetValue(1, 1))
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		byteB, err := json.Marshal(b)
+		require.NoError(t, err)
+
+		res := NewBowEmpty()
+		require.NoError(t, json.Unmarshal(byteB, res))
+		assert.Equal(t, List, res.ColumnType(1))
+		assert.Equal(t, Struct, res.ColumnType(2))
+		assert.Equal(t, []interface{}{1.5, 2.5}, res.GetValue(1, 0))
+		assert.Equal(t, map[string]interface{}{"device": "a", "value": 1.}, res.GetValue(2, 0),
+			fmt.Sprintf("have:\n%v", res))
+	})
+}
@@ -1,17 +1,23 @@
 package bow
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"math/big"
 	"strings"
 	"time"
 
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/decimal128"
 	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go-source/writerfile"
+	"github.com/xitongsys/parquet-go/common"
 	"github.com/xitongsys/parquet-go/layout"
-	"github.com/xitongsys/parquet-go/marshal"
 	"github.com/xitongsys/parquet-go/parquet"
-	"github.com/xitongsys/parquet-go/reader"
 	"github.com/xitongsys/parquet-go/schema"
 	"github.com/xitongsys/parquet-go/source"
 	"github.com/xitongsys/parquet-go/writer"
@@ -22,17 +28,74 @@ var mapParquetToBowTypes = map[parquet.Type]Type{
 	parquet.Type_INT64:      Int64,
 	parquet.Type_DOUBLE:     Float64,
 	parquet.Type_BYTE_ARRAY: String,
+	parquet.Type_INT32:      Int32,
+	parquet.Type_FLOAT:      Float32,
+
+	parquet.Type_FIXED_LEN_BYTE_ARRAY: Binary,
+}
+
+// mapParquetIntegerToBowTypes maps the parquet integer converted types to bow types.
+// Those types are fully represented by the bow type, and are not kept in the column types metadata.
+var mapParquetIntegerToBowTypes = map[parquet.ConvertedType]Type{
+	parquet.ConvertedType_INT_8:   Int8,
+	parquet.ConvertedType_INT_16:  Int16,
+	parquet.ConvertedType_INT_32:  Int32,
+	parquet.ConvertedType_INT_64:  Int64,
+	parquet.ConvertedType_UINT_8:  Uint8,
+	parquet.ConvertedType_UINT_16: Uint16,
+	parquet.ConvertedType_UINT_32: Uint32,
+	parquet.ConvertedType_UINT_64: Uint64,
 }
 
+var mapBowToParquetIntegerTypes = func() map[Type]parquet.ConvertedType {
+	res := make(map[Type]parquet.ConvertedType)
+	for convertedType, bowType := range mapParquetIntegerToBowTypes {
+		if bowType != Int32 && bowType != Int64 {
+			res[bowType] = convertedType
+		}
+	}
+	return res
+}()
+
 var mapBowToParquetTypes = map[Type]parquet.Type{
 	Boolean: parquet.Type_BOOLEAN,
 	Int64:   parquet.Type_INT64,
 	Float64: parquet.Type_DOUBLE,
 	String:  parquet.Type_BYTE_ARRAY,
+
+	TimestampSec:   parquet.Type_INT64,
+	TimestampMilli: parquet.Type_INT64,
+	TimestampMicro: parquet.Type_INT64,
+	TimestampNano:  parquet.Type_INT64,
+
+	Int8:    parquet.Type_INT32,
+	Int16:   parquet.Type_INT32,
+	Int32:   parquet.Type_INT32,
+	Uint8:   parquet.Type_INT32,
+	Uint16:  parquet.Type_INT32,
+	Uint32:  parquet.Type_INT32,
+	Uint64:  parquet.Type_INT64,
+	Float32: parquet.Type_FLOAT,
+
+	Dictionary: parquet.Type_BYTE_ARRAY,
+
+	Decimal: parquet.Type_FIXED_LEN_BYTE_ARRAY,
+
+	Binary: parquet.Type_BYTE_ARRAY,
+
+	Date: parquet.Type_INT32,
 }
 
+// parquetDecimalTypeLength is the byte length of the Decimal values written to parquet.
+const parquetDecimalTypeLength = 16
+
+// keyParquetColTypesMeta is the metadata key of the parquet logical types without a matching bow Type.
 const keyParquetColTypesMeta = "col_types"
 
+// keyParquetBinaryColsMeta is the metadata key of the names of the Binary columns,
+// written as BYTE_ARRAY columns without annotation, which are otherwise read as String columns.
+const keyParquetBinaryColsMeta = "binary_cols"
+
 type parquetColTypesMeta struct {
 	Name        string               `json:"name"`
 	LogicalType *parquet.LogicalType `json:"logical_type"`
@@ -42,117 +105,189 @@ type parquetColTypesMeta struct {
 // Only value columns are used to create the new Bow.
 // Argument verbose is used to print information about the file loaded.
 func NewBowFromParquet(path string, verbose bool) (Bow, error) {
+	return NewBowFromParquetWithOptions(path, ParquetReadOptions{}, verbose)
+}
+
+// NewBowFromParquetWithOptions loads a parquet object from the file path with the ParquetReadOptions `options`,
+// returning a new Bow.
+// Only value columns are used to create the new Bow.
+// Argument verbose is used to print information about the file loaded.
+func NewBowFromParquetWithOptions(path string, options ParquetReadOptions, verbose bool) (Bow, error) {
 	fr, err := local.NewLocalFileReader(path)
 	if err != nil {
 		return nil, fmt.Errorf("local.NewLocalFileReader: %w", err)
 	}
+	defer fr.Close()
 
-	pr := new(reader.ParquetReader)
-	pr.NP = 4
-	pr.PFile = fr
-	if err = pr.ReadFooter(); err != nil {
-		return nil, fmt.Errorf("reader.ParquetReader.ReadFooter: %w", err)
-	}
-	pr.ColumnBuffers = make(map[string]*reader.ColumnBufferType)
-	pr.SchemaHandler = schema.NewSchemaHandlerFromSchemaList(pr.Footer.GetSchema())
-
-	var originalColNames = make([]string, len(pr.Footer.GetSchema()))
-	for i, se := range pr.Footer.GetSchema() {
-		originalColNames[i] = se.Name
-	}
-
-	var originalRowGroups = make([]*parquet.RowGroup, len(pr.Footer.RowGroups))
-	for r, rg := range pr.Footer.RowGroups {
-		var originalCols = make([]*parquet.ColumnChunk, len(rg.Columns))
-		for c, col := range rg.Columns {
-			var originalMetaData = parquet.ColumnMetaData{
-				PathInSchema: col.MetaData.PathInSchema,
-			}
-			var originalCol = parquet.ColumnChunk{
-				MetaData: &originalMetaData,
-			}
-			originalCols[c] = &originalCol
-		}
-		originalRowGroups[r] = &parquet.RowGroup{Columns: originalCols}
-	}
-
-	pr.RenameSchema()
-
-	var valueColIndex int64
-	var series = make([]Series, pr.SchemaHandler.GetColumnNum())
-	var parquetColTypesMetas []parquetColTypesMeta
-	for colIndex, col := range pr.Footer.GetSchema() {
-		if col.NumChildren != nil {
-			continue
-		}
-
-		if col.ConvertedType != nil || col.LogicalType != nil {
-			parquetColTypesMetas = append(parquetColTypesMetas, parquetColTypesMeta{
-				Name:        originalColNames[colIndex],
-				LogicalType: col.LogicalType,
-			})
-		}
+	return newBowFromParquetFile(fr, path, options, verbose)
+}
 
-		values, _, _, err := pr.ReadColumnByIndex(valueColIndex, pr.GetNumRows())
-		if err != nil {
-			return nil, fmt.Errorf("reader.ParquetReader.ReadColumnByIndex: %w", err)
-		}
+// NewBowFromParquetReader loads a parquet object of `size` bytes from `r` with the ParquetReadOptions `options`,
+// returning a new Bow.
+// It allows reading parquet data from any storage backend, such as memory buffers or object stores.
+// Only value columns are used to create the new Bow.
+// Argument verbose is used to print information about the data loaded.
+func NewBowFromParquetReader(r io.ReaderAt, size int64, options ParquetReadOptions, verbose bool) (Bow, error) {
+	return newBowFromParquetFile(newReaderAtParquetFile(r, size), "reader", options, verbose)
+}
 
-		bowType := mapParquetToBowTypes[col.GetType()]
-		buf := NewBuffer(len(values), bowType)
-		for i, v := range values {
-			buf.SetOrDrop(i, v)
-		}
-		series[valueColIndex] = NewSeriesFromBuffer(originalColNames[colIndex], buf)
+// ParquetReadOptions are options to read parquet data:
+// - ColNames: names of the columns to read, in the order of the new Bow, defaults to all the value columns
+// - RowGroupPredicates: predicates on INT64 columns used to skip the row groups
+// which cannot hold any row matching all of them, according to their min/max statistics.
+// The rows of the remaining row groups are not filtered.
+// - BatchSize: maximum number of rows of the Bows returned by ParquetReader.Next, defaults to one Bow per row group
+type ParquetReadOptions struct {
+	ColNames           []string
+	RowGroupPredicates []ParquetPredicate
+	BatchSize          int
+}
 
-		pr.Footer.Schema[colIndex].Name = originalColNames[colIndex]
-		valueColIndex++
+func (o *ParquetReadOptions) validate() {
+	if o.BatchSize < 0 {
+		o.BatchSize = 0
 	}
+}
 
-	for r, rg := range pr.Footer.RowGroups {
-		for c := range rg.Columns {
-			pr.Footer.RowGroups[r].Columns[c].MetaData.PathInSchema = originalRowGroups[r].
-				Columns[c].MetaData.PathInSchema
-		}
-	}
+// ParquetOperator is a comparison operator of a ParquetPredicate.
+type ParquetOperator int
+
+const (
+	// ParquetEq matches the values equal to the predicate value.
+	ParquetEq = ParquetOperator(iota)
+	// ParquetLt matches the values lower than the predicate value.
+	ParquetLt
+	// ParquetLe matches the values lower than or equal to the predicate value.
+	ParquetLe
+	// ParquetGt matches the values greater than the predicate value.
+	ParquetGt
+	// ParquetGe matches the values greater than or equal to the predicate value.
+	ParquetGe
+)
 
-	var keys, values []string
-	for _, m := range pr.Footer.KeyValueMetadata {
-		if m.GetKey() != "ARROW:schema" && m.GetKey() != keyParquetColTypesMeta {
-			keys = append(keys, m.GetKey())
-			values = append(values, m.GetValue())
-		}
-	}
+// ParquetPredicate compares the values of the INT64 parquet column ColName with Value,
+// such as the values of a time column stored in its time unit.
+type ParquetPredicate struct {
+	ColName  string
+	Operator ParquetOperator
+	Value    int64
+}
 
-	if len(parquetColTypesMetas) > 0 {
-		colTypesJSON, err := json.Marshal(parquetColTypesMetas)
-		if err != nil {
-			return nil, fmt.Errorf("json.Marshal: %w", err)
-		}
-		keys = append(keys, keyParquetColTypesMeta)
-		values = append(values, string(colTypesJSON))
+// mayMatch returns whether a column chunk with values in [min, max] may hold values matching the predicate.
+func (p ParquetPredicate) mayMatch(min, max int64) bool {
+	switch p.Operator {
+	case ParquetEq:
+		return min <= p.Value && p.Value <= max
+	case ParquetLt:
+		return min < p.Value
+	case ParquetLe:
+		return min <= p.Value
+	case ParquetGt:
+		return max > p.Value
+	case ParquetGe:
+		return max >= p.Value
 	}
+	return true
+}
 
-	b, err := NewBowWithMetadata(NewMetadata(keys, values), series...)
+func newBowFromParquetFile(pf source.ParquetFile, name string, options ParquetReadOptions, verbose bool) (Bow, error) {
+	options.BatchSize = 0
+	r, err := newParquetReader(pf, options)
 	if err != nil {
-		return nil, fmt.Errorf("NewBowWithMetadata: %w", err)
+		return nil, err
 	}
+	defer r.pr.ReadStop()
 
-	footerIndented, err := json.MarshalIndent(pr.Footer, "", "\t")
+	b, err := r.read(r.pr.GetNumRows())
 	if err != nil {
-		return nil, fmt.Errorf("json.MarshalIndent: %w", err)
+		return nil, err
 	}
 
 	if verbose {
 		fmt.Printf(
 			"bow.NewBowFromParquet: %s successfully read: %d rows\n%+v\n%+v\n",
-			path, b.NumRows(), b.Schema().String(), string(footerIndented))
+			name, b.NumRows(), b.Schema().String(), r.footer)
 	}
 
 	return b, nil
 }
 
+// filterParquetRowGroups removes from `footer` the row groups which cannot hold any row matching all the `predicates`.
+// Row groups without statistics are kept.
+func filterParquetRowGroups(footer *parquet.FileMetaData, predicates []ParquetPredicate) error {
+	if len(predicates) == 0 {
+		return nil
+	}
+
+	for _, predicate := range predicates {
+		var found bool
+		for _, col := range footer.GetSchema() {
+			if col.NumChildren != nil || col.Name != predicate.ColName {
+				continue
+			}
+			found = true
+			if col.GetType() != parquet.Type_INT64 || col.GetConvertedType() == parquet.ConvertedType_UINT_64 {
+				return fmt.Errorf("unsupported predicate on column '%s' of parquet type '%s'",
+					predicate.ColName, col.GetType())
+			}
+		}
+		if !found {
+			return fmt.Errorf("predicate column '%s' not found", predicate.ColName)
+		}
+	}
+
+	var rowGroups []*parquet.RowGroup
+	var numRows int64
+	for _, rg := range footer.RowGroups {
+		if parquetRowGroupMayMatch(rg, predicates) {
+			rowGroups = append(rowGroups, rg)
+			numRows += rg.NumRows
+		}
+	}
+	footer.RowGroups = rowGroups
+	footer.NumRows = numRows
+
+	return nil
+}
+
+func parquetRowGroupMayMatch(rg *parquet.RowGroup, predicates []ParquetPredicate) bool {
+	for _, predicate := range predicates {
+		for _, col := range rg.Columns {
+			path := col.GetMetaData().GetPathInSchema()
+			if len(path) != 1 || path[0] != predicate.ColName {
+				continue
+			}
+			stats := col.GetMetaData().GetStatistics()
+			if stats == nil {
+				continue
+			}
+			// the deprecated Min and Max fields are also signed for INT64 columns
+			minValue, maxValue := stats.MinValue, stats.MaxValue
+			if len(minValue) == 0 || len(maxValue) == 0 {
+				minValue, maxValue = stats.Min, stats.Max
+			}
+			if len(minValue) == 0 || len(maxValue) == 0 {
+				// without min/max values, the row group can only be skipped if all its values are nil
+				if stats.NullCount != nil && stats.GetNullCount() == col.GetMetaData().GetNumValues() {
+					return false
+				}
+				continue
+			}
+			if len(minValue) != 8 || len(maxValue) != 8 {
+				continue
+			}
+			min := int64(binary.LittleEndian.Uint64(minValue))
+			max := int64(binary.LittleEndian.Uint64(maxValue))
+			if !predicate.mayMatch(min, max) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // WriteParquet writes a Bow to the binary parquet format.
+// The suffix `.parquet` is added to `path` if missing.
 // Argument verbose is used to print information about the written file.
 func (b *bow) WriteParquet(path string, verbose bool) error {
 	if b.NumCols() == 0 {
@@ -163,6 +298,49 @@ func (b *bow) WriteParquet(path string, verbose bool) error {
 		path += ".parquet"
 	}
 
+	parquetFile, err := local.NewLocalFileWriter(path)
+	if err != nil {
+		return fmt.Errorf("local.NewLocalFileWriter: %w", err)
+	}
+	defer parquetFile.Close()
+
+	return b.writeParquetFile(parquetFile, path, ParquetWriteOptions{}, verbose)
+}
+
+// ParquetWriteOptions are options to write parquet data:
+// - RowGroupSize: maximum number of rows of each row group, defaults to parquetDefaultRowGroupSize
+// - Compression: compression codec of the column chunks, defaults to SNAPPY
+type ParquetWriteOptions struct {
+	RowGroupSize int
+	Compression  *parquet.CompressionCodec
+}
+
+const parquetDefaultRowGroupSize = 1 << 20
+
+func (o *ParquetWriteOptions) validate() {
+	if o.RowGroupSize <= 0 {
+		o.RowGroupSize = parquetDefaultRowGroupSize
+	}
+	if o.Compression == nil {
+		o.Compression = parquet.CompressionCodecPtr(parquet.CompressionCodec_SNAPPY)
+	}
+}
+
+// WriteParquetTo writes a Bow to `w` in the binary parquet format, with the ParquetWriteOptions `options`.
+// It allows writing parquet data to any storage backend, such as memory buffers or object stores.
+// Argument verbose is used to print information about the written data.
+func (b *bow) WriteParquetTo(w io.Writer, options ParquetWriteOptions, verbose bool) error {
+	if b.NumCols() == 0 {
+		return fmt.Errorf("bow has 0 columns")
+	}
+
+	return b.writeParquetFile(writerfile.NewWriterFile(w), "writer", options, verbose)
+}
+
+// writeParquetFile writes the column chunks directly from the arrow arrays, one row group at a time.
+func (b *bow) writeParquetFile(parquetFile source.ParquetFile, name string, options ParquetWriteOptions, verbose bool) error {
+	options.validate()
+
 	var parquetColTypesMetas []parquetColTypesMeta
 	keyIndex := b.Metadata().FindKey(keyParquetColTypesMeta)
 	if keyIndex != -1 {
@@ -184,12 +362,38 @@ func (b *bow) WriteParquet(path string, verbose bool) error {
 	sElems = append(sElems, sElem)
 	lTypes := []*parquet.LogicalType{nil}
 	for i, f := range b.Schema().Fields() {
-		parquetType := mapBowToParquetTypes[b.ColumnType(i)]
+		parquetType, ok := mapBowToParquetTypes[b.ColumnType(i)]
+		if !ok {
+			return fmt.Errorf("unsupported type '%s' for column '%s'", b.ColumnType(i), f.Name)
+		}
 		sElem = parquet.NewSchemaElement()
 		sElem.Type = &parquetType
 		optionalRepType := parquet.FieldRepetitionType_OPTIONAL
 		sElem.RepetitionType = &optionalRepType
 		sElem.Name = f.Name
+		if convertedType, ok := mapBowToParquetIntegerTypes[b.ColumnType(i)]; ok {
+			sElem.ConvertedType = &convertedType
+		}
+		switch b.ColumnType(i) {
+		case String:
+			convertedType := parquet.ConvertedType_UTF8
+			sElem.ConvertedType = &convertedType
+		case Dictionary:
+			// parquet-go has no function table for ENUM values, UTF8 is used to write their statistics
+			convertedType := parquet.ConvertedType_UTF8
+			sElem.ConvertedType = &convertedType
+		case Date:
+			convertedType := parquet.ConvertedType_DATE
+			sElem.ConvertedType = &convertedType
+		case Decimal:
+			// The DECIMAL converted type is not set, as parquet-go would compute the statistics with 64 bits floats.
+			typeLength := int32(parquetDecimalTypeLength)
+			precision, scale := int32(DecimalPrecision), int32(DecimalScale)
+			sElem.TypeLength = &typeLength
+			sElem.Precision = &precision
+			sElem.Scale = &scale
+		}
+		sElem.LogicalType = newParquetLogicalType(b.ColumnType(i))
 		for j, t := range parquetColTypesMetas {
 			if t.Name == f.Name {
 				sElem.LogicalType = parquetColTypesMetas[j].LogicalType
@@ -199,19 +403,15 @@ func (b *bow) WriteParquet(path string, verbose bool) error {
 		lTypes = append(lTypes, sElem.LogicalType)
 	}
 
-	parquetFile, err := local.NewLocalFileWriter(path)
+	parquetWriter, err := writer.NewParquetWriter(parquetFile, sElems, 4)
 	if err != nil {
-		return fmt.Errorf("local.NewLocalFileWriter: %w", err)
-	}
-	defer parquetFile.Close()
-
-	parquetWriter, err := newJSONWriter(sElems, parquetFile, 4)
-	if err != nil {
-		return fmt.Errorf("newJSONWriter: %w", err)
+		return fmt.Errorf("writer.NewParquetWriter: %w", err)
 	}
+	parquetWriter.CompressionType = *options.Compression
+	parquetWriter.MarshalFunc = b.marshalParquetColumns
 
 	for k, key := range b.Metadata().Keys() {
-		if key != "ARROW:schema" {
+		if key != "ARROW:schema" && key != keyParquetBinaryColsMeta {
 			parquetWriter.Footer.KeyValueMetadata = append(parquetWriter.Footer.KeyValueMetadata,
 				&parquet.KeyValue{
 					Key:   key,
@@ -220,22 +420,56 @@ func (b *bow) WriteParquet(path string, verbose bool) error {
 		}
 	}
 
+	var binaryColNames []string
+	for colIndex := 0; colIndex < b.NumCols(); colIndex++ {
+		if b.ColumnType(colIndex) == Binary {
+			binaryColNames = append(binaryColNames, b.ColumnName(colIndex))
+		}
+	}
+	if len(binaryColNames) > 0 {
+		binaryColsJSON, err := json.Marshal(binaryColNames)
+		if err != nil {
+			return fmt.Errorf("json.Marshal: %w", err)
+		}
+		binaryCols := string(binaryColsJSON)
+		parquetWriter.Footer.KeyValueMetadata = append(parquetWriter.Footer.KeyValueMetadata,
+			&parquet.KeyValue{
+				Key:   keyParquetBinaryColsMeta,
+				Value: &binaryCols,
+			})
+	}
+
 	for i, lt := range lTypes {
 		parquetWriter.SchemaHandler.SchemaElements[i].LogicalType = lt
 	}
 
-	for row := range b.GetRowsChan() {
-		rowJSON, err := json.Marshal(row)
-		if err != nil {
-			return fmt.Errorf("json.Marshal: %w", err)
+	// Dictionary columns are written with the parquet dictionary encoding
+	for colIndex := 0; colIndex < b.NumCols(); colIndex++ {
+		if b.ColumnType(colIndex) == Dictionary {
+			parquetWriter.SchemaHandler.Infos[colIndex+1].Encoding = parquet.Encoding_PLAIN_DICTIONARY
+		}
+	}
+
+	// The row indices are used as objects to write, and are marshaled by marshalParquetColumns.
+	// Each flush writes a row group.
+	rowIndices := make([]interface{}, b.NumRows())
+	for rowIndex := range rowIndices {
+		rowIndices[rowIndex] = rowIndex
+	}
+	for offset := 0; offset < b.NumRows(); offset += options.RowGroupSize {
+		end := offset + options.RowGroupSize
+		if end > b.NumRows() {
+			end = b.NumRows()
 		}
-		if err = parquetWriter.Write(string(rowJSON)); err != nil {
-			return fmt.Errorf("JSONWriter.Write: %w", err)
+		parquetWriter.Objs = rowIndices[offset:end]
+		if err = parquetWriter.Flush(true); err != nil {
+			return fmt.Errorf("writer.ParquetWriter.Flush: %w", err)
 		}
+		b.setParquetNullCounts(parquetWriter.Footer.RowGroups[len(parquetWriter.Footer.RowGroups)-1], offset, end)
 	}
 
 	if err = parquetWriter.WriteStop(); err != nil {
-		return fmt.Errorf("JSONWriter.WriteStop: %w", err)
+		return fmt.Errorf("writer.ParquetWriter.WriteStop: %w", err)
 	}
 
 	footerBytes, err := json.MarshalIndent(parquetWriter.Footer, "", "\t")
@@ -246,19 +480,323 @@ func (b *bow) WriteParquet(path string, verbose bool) error {
 	if verbose {
 		fmt.Printf(
 			"bow.WriteParquet: %s successfully written: %d rows\n%s\n",
-			path, parquetWriter.Footer.NumRows, string(footerBytes))
+			name, parquetWriter.Footer.NumRows, string(footerBytes))
 	}
 
 	return nil
 }
 
+// setParquetNullCounts sets the exact null counts in the statistics of the column chunks of the row group `rg`,
+// holding the rows from `offset` to `end`, as parquet-go does not count the first value of each page.
+// The column chunks are in the order of the columns.
+func (b *bow) setParquetNullCounts(rg *parquet.RowGroup, offset, end int) {
+	for colIndex, chunk := range rg.Columns {
+		stats := chunk.GetMetaData().GetStatistics()
+		if stats == nil || stats.NullCount == nil {
+			continue
+		}
+		col := b.Column(colIndex)
+		var nullCount int64
+		for rowIndex := offset; rowIndex < end; rowIndex++ {
+			if col.IsNull(rowIndex) {
+				nullCount++
+			}
+		}
+		stats.NullCount = &nullCount
+	}
+}
+
+// newParquetLogicalType returns the parquet logical type matching the bow Type,
+// or nil if the Type has no corresponding logical type.
+// TimestampSec is written with the MILLIS unit, as parquet has no second time unit.
+// Timestamps are always adjusted to UTC, their time zone not being kept.
+func newParquetLogicalType(typ Type) *parquet.LogicalType {
+	logicalType := parquet.NewLogicalType()
+	switch typ {
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		unit := parquet.NewTimeUnit()
+		switch typ {
+		case TimestampSec, TimestampMilli:
+			unit.MILLIS = parquet.NewMilliSeconds()
+		case TimestampMicro:
+			unit.MICROS = parquet.NewMicroSeconds()
+		case TimestampNano:
+			unit.NANOS = parquet.NewNanoSeconds()
+		}
+		logicalType.TIMESTAMP = parquet.NewTimestampType()
+		logicalType.TIMESTAMP.IsAdjustedToUTC = true
+		logicalType.TIMESTAMP.Unit = unit
+	case Int8, Int16, Uint8, Uint16, Uint32, Uint64:
+		logicalType.INTEGER = parquet.NewIntType()
+		logicalType.INTEGER.BitWidth = int8(mapBowToArrowTypes[typ].(arrow.FixedWidthDataType).BitWidth())
+		logicalType.INTEGER.IsSigned = typ == Int8 || typ == Int16
+	case String:
+		logicalType.STRING = parquet.NewStringType()
+	case Dictionary:
+		logicalType.ENUM = parquet.NewEnumType()
+	case Date:
+		logicalType.DATE = parquet.NewDateType()
+	case Decimal:
+		logicalType.DECIMAL = parquet.NewDecimalType()
+		logicalType.DECIMAL.Precision = DecimalPrecision
+		logicalType.DECIMAL.Scale = DecimalScale
+	default:
+		return nil
+	}
+	return logicalType
+}
+
+// fromParquetValue reinterprets the unsigned values read from parquet,
+// which are returned as signed physical values by the reader.
+func fromParquetValue(value interface{}, typ Type) interface{} {
+	switch v := value.(type) {
+	case int32:
+		switch typ {
+		case Uint32:
+			return uint32(v)
+		case Date:
+			return arrow.Date32(v)
+		}
+	case int64:
+		switch typ {
+		case Uint64:
+			return uint64(v)
+		case TimestampMilli, TimestampMicro, TimestampNano:
+			return arrow.Timestamp(v)
+		}
+	}
+	return value
+}
+
+// marshalParquetColumns is a parquet marshal function building the column tables directly from the arrow arrays,
+// for the rows whose contiguous indices are given by `src`.
+func (b *bow) marshalParquetColumns(src []interface{}, sh *schema.SchemaHandler) (*map[string]*layout.Table, error) {
+	res := make(map[string]*layout.Table, b.NumCols())
+	if len(src) == 0 {
+		return &res, nil
+	}
+
+	firstRowIndex := src[0].(int)
+	for colIndex := 0; colIndex < b.NumCols(); colIndex++ {
+		schemaIndex := int32(colIndex + 1)
+		pathStr := sh.IndexMap[schemaIndex]
+		table := layout.NewEmptyTable()
+		table.Path = common.StrToPath(pathStr)
+		table.MaxDefinitionLevel = 1
+		table.RepetitionType = parquet.FieldRepetitionType_OPTIONAL
+		table.Schema = sh.SchemaElements[schemaIndex]
+		table.Info = sh.Infos[schemaIndex]
+		table.Values = make([]interface{}, len(src))
+		table.DefinitionLevels = make([]int32, len(src))
+		table.RepetitionLevels = make([]int32, len(src))
+
+		col := b.Column(colIndex)
+		for i := range src {
+			if col.IsValid(firstRowIndex + i) {
+				table.DefinitionLevels[i] = 1
+			}
+		}
+		b.fillParquetValues(colIndex, firstRowIndex, table.Values)
+
+		res[pathStr] = table
+	}
+
+	return &res, nil
+}
+
+// fillParquetValues fills `values` with the non-nil values of the column `colIndex` starting from the row `firstRowIndex`,
+// converted to the go type of their parquet physical type.
+func (b *bow) fillParquetValues(colIndex, firstRowIndex int, values []interface{}) {
+	col := b.Column(colIndex)
+	data := col.Data()
+	fill := func(fn func(rowIndex int) interface{}) {
+		for i := range values {
+			if col.IsValid(firstRowIndex + i) {
+				values[i] = fn(firstRowIndex + i)
+			}
+		}
+	}
+
+	switch b.ColumnType(colIndex) {
+	case Int64:
+		arr := array.NewInt64Data(data)
+		fill(func(i int) interface{} { return arr.Value(i) })
+	case Float64:
+		arr := array.NewFloat64Data(data)
+		fill(func(i int) interface{} { return arr.Value(i) })
+	case Boolean:
+		arr := array.NewBooleanData(data)
+		fill(func(i int) interface{} { return arr.Value(i) })
+	case String:
+		arr := array.NewStringData(data)
+		fill(func(i int) interface{} { return arr.Value(i) })
+	case TimestampSec:
+		// parquet has no second time unit, see newParquetLogicalType
+		arr := array.NewTimestampData(data)
+		fill(func(i int) interface{} { return int64(arr.Value(i)) * 1000 })
+	case TimestampMilli, TimestampMicro, TimestampNano:
+		arr := array.NewTimestampData(data)
+		fill(func(i int) interface{} { return int64(arr.Value(i)) })
+	case Date:
+		arr := array.NewDate32Data(data)
+		fill(func(i int) interface{} { return int32(arr.Value(i)) })
+	case Int8:
+		arr := array.NewInt8Data(data)
+		fill(func(i int) interface{} { return int32(arr.Value(i)) })
+	case Int16:
+		arr := array.NewInt16Data(data)
+		fill(func(i int) interface{} { return int32(arr.Value(i)) })
+	case Int32:
+		arr := array.NewInt32Data(data)
+		fill(func(i int) interface{} { return arr.Value(i) })
+	case Uint8:
+		arr := array.NewUint8Data(data)
+		fill(func(i int) interface{} { return int32(arr.Value(i)) })
+	case Uint16:
+		arr := array.NewUint16Data(data)
+		fill(func(i int) interface{} { return int32(arr.Value(i)) })
+	case Uint32:
+		// Unsigned values are stored as their signed physical type, see fromParquetValue
+		arr := array.NewUint32Data(data)
+		fill(func(i int) interface{} { return int32(arr.Value(i)) })
+	case Uint64:
+		arr := array.NewUint64Data(data)
+		fill(func(i int) interface{} { return int64(arr.Value(i)) })
+	case Float32:
+		arr := array.NewFloat32Data(data)
+		fill(func(i int) interface{} { return arr.Value(i) })
+	case Dictionary:
+		arr := array.NewDictionaryData(data)
+		dict := arr.Dictionary().(*array.String)
+		fill(func(i int) interface{} { return dict.Value(arr.GetValueIndex(i)) })
+	case Decimal:
+		arr := array.NewDecimal128Data(data)
+		fill(func(i int) interface{} { return toParquetDecimalValue(arr.Value(i)) })
+	case Binary:
+		arr := array.NewBinaryData(data)
+		fill(func(i int) interface{} { return string(arr.Value(i)) })
+	default:
+		panic(fmt.Errorf("unsupported type '%s'", b.ColumnType(colIndex)))
+	}
+}
+
+// toParquetDecimalValue encodes a decimal value into a big-endian two's complement integer.
+func toParquetDecimalValue(num decimal128.Num) string {
+	bytes := make([]byte, parquetDecimalTypeLength)
+	binary.BigEndian.PutUint64(bytes[:8], uint64(num.HighBits()))
+	binary.BigEndian.PutUint64(bytes[8:], num.LowBits())
+	return string(bytes)
+}
+
+// isParquetString returns true if the parquet column holds UTF8 strings,
+// as opposed to BYTE_ARRAY columns without annotation which hold raw bytes.
+func isParquetString(col *parquet.SchemaElement) bool {
+	if col.GetType() != parquet.Type_BYTE_ARRAY {
+		return false
+	}
+	if col.LogicalType != nil && (col.LogicalType.IsSetSTRING() || col.LogicalType.IsSetJSON()) {
+		return true
+	}
+	if col.ConvertedType != nil {
+		switch col.GetConvertedType() {
+		case parquet.ConvertedType_UTF8, parquet.ConvertedType_JSON:
+			return true
+		}
+	}
+	return false
+}
+
+// getParquetTimestampType returns the timestamp Type of an INT64 column annotated with the TIMESTAMP logical type,
+// or with one of the legacy TIMESTAMP_MILLIS and TIMESTAMP_MICROS converted types.
+func getParquetTimestampType(col *parquet.SchemaElement) (Type, bool) {
+	if col.GetType() != parquet.Type_INT64 {
+		return Unknown, false
+	}
+
+	if col.LogicalType != nil && col.LogicalType.IsSetTIMESTAMP() {
+		unit := col.LogicalType.TIMESTAMP.GetUnit()
+		switch {
+		case unit.IsSetMILLIS():
+			return TimestampMilli, true
+		case unit.IsSetMICROS():
+			return TimestampMicro, true
+		case unit.IsSetNANOS():
+			return TimestampNano, true
+		}
+		return Unknown, false
+	}
+
+	if col.ConvertedType != nil {
+		switch col.GetConvertedType() {
+		case parquet.ConvertedType_TIMESTAMP_MILLIS:
+			return TimestampMilli, true
+		case parquet.ConvertedType_TIMESTAMP_MICROS:
+			return TimestampMicro, true
+		}
+	}
+
+	return Unknown, false
+}
+
+// isParquetDate returns whether an INT32 column is annotated with the DATE logical or converted type.
+func isParquetDate(col *parquet.SchemaElement) bool {
+	if col.GetType() != parquet.Type_INT32 {
+		return false
+	}
+	return (col.LogicalType != nil && col.LogicalType.IsSetDATE()) ||
+		(col.ConvertedType != nil && col.GetConvertedType() == parquet.ConvertedType_DATE)
+}
+
+// getParquetDecimalScale returns the scale of a parquet DECIMAL column, and false if the column is not a DECIMAL.
+// The values of any precision and scale are rescaled to the Decimal type by fromParquetDecimalValue.
+func getParquetDecimalScale(col *parquet.SchemaElement) (int32, bool) {
+	switch {
+	case col.LogicalType != nil && col.LogicalType.IsSetDECIMAL():
+		return col.LogicalType.DECIMAL.Scale, true
+	case col.GetConvertedType() == parquet.ConvertedType_DECIMAL && col.ConvertedType != nil:
+		return col.GetScale(), true
+	default:
+		return 0, false
+	}
+}
+
+// fromParquetDecimalValue converts an unscaled parquet DECIMAL value with the scale `scale` to a Decimal128,
+// rounded half away from zero if `scale` is greater than DecimalScale.
+// Returns an error if the value exceeds DecimalPrecision digits.
+func fromParquetDecimalValue(value interface{}, scale int32) (interface{}, error) {
+	var unscaled *big.Int
+	switch v := value.(type) {
+	case int32:
+		unscaled = big.NewInt(int64(v))
+	case int64:
+		unscaled = big.NewInt(v)
+	case string:
+		unscaled = new(big.Int).SetBytes([]byte(v))
+		if len(v) > 0 && v[0]&0x80 != 0 {
+			unscaled.Sub(unscaled, new(big.Int).Lsh(big.NewInt(1), uint(len(v)*8)))
+		}
+	default:
+		return nil, nil
+	}
+
+	d, ok := newDecimal128FromScaledBigInt(unscaled, scale)
+	if !ok {
+		return nil, fmt.Errorf("value exceeds %d digits with a scale of %d", DecimalPrecision, DecimalScale)
+	}
+	return d, nil
+}
+
 var ErrColTimeUnitNotFound = errors.New("column time unit not found in parquet metadata")
 
 // GetParquetMetaColTimeUnit attempts to get the time unit of the column as a time.Duration
 // from the bow metadata read from a parquet file.
+// If the column is of a timestamp Type, its own time unit is returned.
 // If no time unit metadata is found, time.Duration(0) is returned along with ErrColTimeUnitNotFound.
 func (b *bow) GetParquetMetaColTimeUnit(colIndex int) (time.Duration, error) {
 	colName := b.ColumnName(colIndex)
+	if colType := b.ColumnType(colIndex); colType.IsTimestamp() {
+		return colType.TimeUnit().Multiplier(), nil
+	}
 
 	keyIndex := b.Metadata().FindKey(keyParquetColTypesMeta)
 	if keyIndex == -1 {
@@ -323,28 +861,34 @@ func readColTypesMeta(b Bow, jsonEncodedData string) ([]parquetColTypesMeta, err
 	return colTypesMeta, nil
 }
 
-func newJSONWriter(se []*parquet.SchemaElement, pfile source.ParquetFile, np int64) (*writer.JSONWriter, error) {
-	res := new(writer.JSONWriter)
-	res.SchemaHandler = schema.NewSchemaHandlerFromSchemaList(se)
-	res.SchemaHandler.CreateInExMap()
-
-	res.PFile = pfile
-	res.PageSize = 8 * 1024              //8K
-	res.RowGroupSize = 128 * 1024 * 1024 //128M
-	res.CompressionType = parquet.CompressionCodec_SNAPPY
-	res.PagesMapBuf = make(map[string][]*layout.Page)
-	res.DictRecs = make(map[string]*layout.DictRecType)
-	res.NP = np
-	res.Footer = parquet.NewFileMetaData()
-	res.Footer.Version = 1
-	res.Footer.Schema = append(res.Footer.Schema, res.SchemaHandler.SchemaElements...)
-	res.Offset = 4
-	res.MarshalFunc = marshal.MarshalJSON
-
-	_, err := res.PFile.Write([]byte("PAR1"))
-	if err != nil {
-		return nil, err
+// readerAtParquetFile is a read-only source.ParquetFile over an io.ReaderAt,
+// each opened file having its own position.
+type readerAtParquetFile struct {
+	*io.SectionReader
+	r    io.ReaderAt
+	size int64
+}
+
+func newReaderAtParquetFile(r io.ReaderAt, size int64) *readerAtParquetFile {
+	return &readerAtParquetFile{
+		SectionReader: io.NewSectionReader(r, 0, size),
+		r:             r,
+		size:          size,
 	}
+}
+
+func (f *readerAtParquetFile) Open(string) (source.ParquetFile, error) {
+	return newReaderAtParquetFile(f.r, f.size), nil
+}
+
+func (f *readerAtParquetFile) Create(string) (source.ParquetFile, error) {
+	return nil, errors.New("readerAtParquetFile is read-only")
+}
 
-	return res, nil
+func (f *readerAtParquetFile) Write([]byte) (int, error) {
+	return 0, errors.New("readerAtParquetFile is read-only")
+}
+
+func (f *readerAtParquetFile) Close() error {
+	return nil
 }
@@ -1,6 +1,7 @@
 package bow
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -10,7 +11,10 @@ import (
 	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/local"
 	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/writer"
 )
 
 const (
@@ -55,6 +59,309 @@ func TestParquet(t *testing.T) {
 		require.NoError(t, os.Remove(testOutputFileName+"_withrows.parquet"))
 	})
 
+	t.Run("narrower numeric types with rows and nil values", func(t *testing.T) {
+		bBefore, err := NewBowFromRowBasedInterfaces(
+			[]string{"int8", "int16", "int32", "uint8", "uint16", "uint32", "uint64", "float32"},
+			[]Type{Int8, Int16, Int32, Uint8, Uint16, Uint32, Uint64, Float32},
+			[][]interface{}{
+				{-128, -32768, -2147483648, 255, 65535, uint32(4294967295), uint64(18446744073709551615), 1.5},
+				{nil, nil, nil, nil, nil, nil, nil, nil},
+				{1, 2, 3, 4, 5, 6, 7, 8.},
+			})
+		require.NoError(t, err)
+
+		assert.NoError(t, bBefore.WriteParquet(testOutputFileName+"_narrower", false))
+
+		bAfter, err := NewBowFromParquet(testOutputFileName+"_narrower.parquet", false)
+		assert.NoError(t, err)
+
+		ExpectEqual(t, bBefore, bAfter)
+
+		require.NoError(t, os.Remove(testOutputFileName+"_narrower.parquet"))
+	})
+
+	t.Run("dictionary columns are dictionary encoded", func(t *testing.T) {
+		bBefore, err := NewBowFromRowBasedInterfaces(
+			[]string{"device", "value"},
+			[]Type{Dictionary, Float64},
+			[][]interface{}{
+				{"a", 1.},
+				{"b", 2.},
+				{nil, 3.},
+				{"a", 4.},
+			})
+		require.NoError(t, err)
+
+		assert.NoError(t, bBefore.WriteParquet(testOutputFileName+"_dictionary", false))
+
+		fr, err := local.NewLocalFileReader(testOutputFileName + "_dictionary.parquet")
+		require.NoError(t, err)
+		pr, err := reader.NewParquetReader(fr, nil, 1)
+		require.NoError(t, err)
+		assert.Contains(t, pr.Footer.RowGroups[0].Columns[0].MetaData.Encodings,
+			parquet.Encoding_PLAIN_DICTIONARY)
+		pr.ReadStop()
+		require.NoError(t, fr.Close())
+
+		bAfter, err := NewBowFromParquet(testOutputFileName+"_dictionary.parquet", false)
+		assert.NoError(t, err)
+
+		ExpectEqual(t, bBefore, bAfter)
+
+		require.NoError(t, os.Remove(testOutputFileName+"_dictionary.parquet"))
+	})
+
+	t.Run("decimal values are written exactly with their logical type", func(t *testing.T) {
+		bBefore, err := NewBowFromRowBasedInterfaces(
+			[]string{"energy"},
+			[]Type{Decimal},
+			[][]interface{}{
+				{"12345678901234567890123456789.123456789"},
+				{nil},
+				{"-0.000000001"},
+				{"42"},
+			})
+		require.NoError(t, err)
+
+		assert.NoError(t, bBefore.WriteParquet(testOutputFileName+"_decimal", false))
+
+		fr, err := local.NewLocalFileReader(testOutputFileName + "_decimal.parquet")
+		require.NoError(t, err)
+		pr, err := reader.NewParquetReader(fr, nil, 1)
+		require.NoError(t, err)
+		logicalType := pr.Footer.Schema[1].GetLogicalType()
+		require.True(t, logicalType.IsSetDECIMAL())
+		assert.Equal(t, int32(DecimalPrecision), logicalType.DECIMAL.Precision)
+		assert.Equal(t, int32(DecimalScale), logicalType.DECIMAL.Scale)
+		pr.ReadStop()
+		require.NoError(t, fr.Close())
+
+		bAfter, err := NewBowFromParquet(testOutputFileName+"_decimal.parquet", false)
+		assert.NoError(t, err)
+
+		ExpectEqual(t, bBefore, bAfter)
+
+		require.NoError(t, os.Remove(testOutputFileName+"_decimal.parquet"))
+	})
+
+	t.Run("decimal values of any precision and scale are rescaled", func(t *testing.T) {
+		var buf bytes.Buffer
+		parquetWriter, err := writer.NewJSONWriterFromWriter(
+			`{"Tag": "name=schema, repetitiontype=REQUIRED", "Fields": [{"Tag": "name=energy, type=INT64, `+
+				`convertedtype=DECIMAL, precision=18, scale=12, repetitiontype=OPTIONAL"}]}`, &buf, 1)
+		require.NoError(t, err)
+		for _, v := range []string{"1.234567890125", "null", "-0.000000000005"} {
+			require.NoError(t, parquetWriter.Write(fmt.Sprintf(`{"energy": %s}`, v)))
+		}
+		require.NoError(t, parquetWriter.WriteStop())
+
+		data := buf.Bytes()
+		b, err := NewBowFromParquetReader(bytes.NewReader(data), int64(len(data)), ParquetReadOptions{}, false)
+		require.NoError(t, err)
+		expected, err := NewBowFromColBasedInterfaces([]string{"energy"}, []Type{Decimal},
+			[][]interface{}{{"1.234567890", nil, "0"}})
+		require.NoError(t, err)
+		ExpectEqual(t, expected, b)
+	})
+
+	t.Run("binary values are written as raw bytes distinct from strings", func(t *testing.T) {
+		bBefore, err := NewBowFromRowBasedInterfaces(
+			[]string{"hash", "name"},
+			[]Type{Binary, String},
+			[][]interface{}{
+				{[]byte{0x00, 0xff, 0x10}, "a"},
+				{nil, nil},
+				{[]byte{}, "b"},
+			})
+		require.NoError(t, err)
+
+		assert.NoError(t, bBefore.WriteParquet(testOutputFileName+"_binary", false))
+
+		fr, err := local.NewLocalFileReader(testOutputFileName + "_binary.parquet")
+		require.NoError(t, err)
+		pr, err := reader.NewParquetReader(fr, nil, 1)
+		require.NoError(t, err)
+		assert.Nil(t, pr.Footer.Schema[1].ConvertedType)
+		assert.Nil(t, pr.Footer.Schema[1].LogicalType)
+		assert.Equal(t, parquet.ConvertedType_UTF8, pr.Footer.Schema[2].GetConvertedType())
+		pr.ReadStop()
+		require.NoError(t, fr.Close())
+
+		bAfter, err := NewBowFromParquet(testOutputFileName+"_binary.parquet", false)
+		assert.NoError(t, err)
+
+		ExpectEqual(t, bBefore, bAfter)
+
+		require.NoError(t, os.Remove(testOutputFileName+"_binary.parquet"))
+	})
+
+	t.Run("byte arrays without annotation are read as strings", func(t *testing.T) {
+		b, err := NewBowFromParquet(benchmarkBowsDirPath+"bow1-10-rows.parquet", false)
+		require.NoError(t, err)
+		colIndex, err := b.ColumnIndex("String_bow1")
+		require.NoError(t, err)
+		assert.Equal(t, String, b.ColumnType(colIndex))
+	})
+
+	t.Run("read/write with io.Reader and io.Writer", func(t *testing.T) {
+		bBefore, err := NewBowFromRowBasedInterfaces(
+			[]string{"int", "float", "bool", "string"},
+			[]Type{Int64, Float64, Boolean, String},
+			[][]interface{}{
+				{1, 1., true, "hi"},
+				{nil, nil, nil, nil},
+				{3, 3., true, "hu"},
+			})
+		require.NoError(t, err)
+		bBefore = bBefore.SetMetadata("source", "memory")
+
+		var buf bytes.Buffer
+		require.NoError(t, bBefore.WriteParquetTo(&buf, ParquetWriteOptions{}, false))
+
+		bAfter, err := NewBowFromParquetReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), ParquetReadOptions{}, false)
+		require.NoError(t, err)
+
+		ExpectEqual(t, bBefore, bAfter)
+		assert.Equal(t, bBefore.Metadata(), bAfter.Metadata())
+	})
+
+	t.Run("row group size and compression options", func(t *testing.T) {
+		bBefore, err := NewBowFromRowBasedInterfaces(
+			[]string{"int", "category", "energy"},
+			[]Type{Int64, Dictionary, Decimal},
+			[][]interface{}{
+				{1, "a", "0.1"},
+				{nil, nil, nil},
+				{3, "b", "-3"},
+				{4, "a", "4.5"},
+				{5, "c", nil},
+			})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, bBefore.WriteParquetTo(&buf, ParquetWriteOptions{
+			RowGroupSize: 2,
+			Compression:  parquet.CompressionCodecPtr(parquet.CompressionCodec_GZIP),
+		}, false))
+
+		pr, err := reader.NewParquetReader(newReaderAtParquetFile(bytes.NewReader(buf.Bytes()), int64(buf.Len())), nil, 1)
+		require.NoError(t, err)
+		require.Len(t, pr.Footer.RowGroups, 3)
+		for _, rg := range pr.Footer.RowGroups {
+			for _, col := range rg.Columns {
+				assert.Equal(t, parquet.CompressionCodec_GZIP, col.MetaData.Codec)
+			}
+		}
+		assert.Equal(t, int64(1), pr.Footer.RowGroups[2].NumRows)
+		pr.ReadStop()
+
+		bAfter, err := NewBowFromParquetReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), ParquetReadOptions{}, false)
+		require.NoError(t, err)
+
+		ExpectEqual(t, bBefore, bAfter)
+	})
+
+	t.Run("column projection and row group predicates", func(t *testing.T) {
+		bBefore, err := NewBowFromRowBasedInterfaces(
+			[]string{"time", "value", "name"},
+			[]Type{Int64, Float64, String},
+			[][]interface{}{
+				{1, 1., "a"},
+				{2, 2., "b"},
+				{3, 3., "c"},
+				{4, 4., "d"},
+				{nil, 5., "e"},
+				{nil, 6., "f"},
+			})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, bBefore.WriteParquetTo(&buf, ParquetWriteOptions{RowGroupSize: 2}, false))
+		read := func(options ParquetReadOptions) (Bow, error) {
+			return NewBowFromParquetReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), options, false)
+		}
+
+		t.Run("columns in the given order", func(t *testing.T) {
+			res, err := read(ParquetReadOptions{ColNames: []string{"name", "time"}})
+			require.NoError(t, err)
+
+			expected, err := NewBow(bBefore.NewSeriesFromCol(2), bBefore.NewSeriesFromCol(0))
+			require.NoError(t, err)
+			ExpectEqual(t, expected, res)
+		})
+
+		t.Run("unknown column", func(t *testing.T) {
+			_, err := read(ParquetReadOptions{ColNames: []string{"unknown"}})
+			assert.Error(t, err)
+		})
+
+		t.Run("time range", func(t *testing.T) {
+			res, err := read(ParquetReadOptions{
+				ColNames: []string{"time", "value"},
+				RowGroupPredicates: []ParquetPredicate{
+					{ColName: "time", Operator: ParquetGe, Value: 2},
+					{ColName: "time", Operator: ParquetLt, Value: 3},
+				},
+			})
+			require.NoError(t, err)
+
+			expected, err := NewBowFromRowBasedInterfaces(
+				[]string{"time", "value"},
+				[]Type{Int64, Float64},
+				[][]interface{}{
+					{1, 1.},
+					{2, 2.},
+				})
+			require.NoError(t, err)
+			ExpectEqual(t, expected, res)
+		})
+
+		testCases := []struct {
+			predicate    ParquetPredicate
+			expectedRows int
+		}{
+			{ParquetPredicate{ColName: "time", Operator: ParquetEq, Value: 3}, 2},
+			{ParquetPredicate{ColName: "time", Operator: ParquetEq, Value: 5}, 0},
+			{ParquetPredicate{ColName: "time", Operator: ParquetLt, Value: 2}, 2},
+			{ParquetPredicate{ColName: "time", Operator: ParquetLe, Value: 3}, 4},
+			{ParquetPredicate{ColName: "time", Operator: ParquetGt, Value: 2}, 2},
+			{ParquetPredicate{ColName: "time", Operator: ParquetGe, Value: 1}, 4},
+		}
+		for _, testCase := range testCases {
+			res, err := read(ParquetReadOptions{RowGroupPredicates: []ParquetPredicate{testCase.predicate}})
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedRows, res.NumRows(), "%+v", testCase.predicate)
+			assert.Equal(t, 3, res.NumCols())
+		}
+
+		t.Run("row groups without min/max values", func(t *testing.T) {
+			predicates := []ParquetPredicate{{ColName: "time", Operator: ParquetEq, Value: 1}}
+			newRowGroup := func(nullCount, numValues int64) *parquet.RowGroup {
+				return &parquet.RowGroup{Columns: []*parquet.ColumnChunk{{MetaData: &parquet.ColumnMetaData{
+					PathInSchema: []string{"time"},
+					NumValues:    numValues,
+					Statistics:   &parquet.Statistics{NullCount: &nullCount},
+				}}}}
+			}
+
+			assert.False(t, parquetRowGroupMayMatch(newRowGroup(2, 2), predicates))
+			assert.True(t, parquetRowGroupMayMatch(newRowGroup(1, 2), predicates))
+			assert.True(t, parquetRowGroupMayMatch(newRowGroup(0, 2), predicates))
+		})
+
+		t.Run("unsupported predicate column", func(t *testing.T) {
+			_, err := read(ParquetReadOptions{RowGroupPredicates: []ParquetPredicate{
+				{ColName: "value", Operator: ParquetEq, Value: 1},
+			}})
+			assert.Error(t, err)
+
+			_, err = read(ParquetReadOptions{RowGroupPredicates: []ParquetPredicate{
+				{ColName: "unknown", Operator: ParquetEq, Value: 1},
+			}})
+			assert.Error(t, err)
+		})
+	})
+
 	t.Run("bow supported types without rows", func(t *testing.T) {
 		bBefore, err := NewBowFromRowBasedInterfaces(
 			[]string{"int", "float", "bool", "string"},
@@ -72,6 +379,54 @@ func TestParquet(t *testing.T) {
 		require.NoError(t, os.Remove(testOutputFileName+"_norows.parquet"))
 	})
 
+	t.Run("timestamp and date types are read and written with their logical type", func(t *testing.T) {
+		bBefore, err := NewBowFromRowBasedInterfaces(
+			[]string{"milli", "micro", "nano", "date", "value"},
+			[]Type{TimestampMilli, TimestampMicro, TimestampNano, Date, Float64},
+			[][]interface{}{
+				{1, 1, 1, "2024-01-01", 1.},
+				{nil, nil, nil, nil, 2.},
+			})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, bBefore.WriteParquetTo(&buf, ParquetWriteOptions{}, false))
+
+		pr, err := reader.NewParquetReader(newReaderAtParquetFile(bytes.NewReader(buf.Bytes()), int64(buf.Len())), nil, 1)
+		require.NoError(t, err)
+		assert.True(t, pr.Footer.Schema[2].LogicalType.TIMESTAMP.Unit.IsSetMICROS())
+		assert.True(t, pr.Footer.Schema[4].LogicalType.IsSetDATE())
+		assert.Equal(t, parquet.ConvertedType_DATE, pr.Footer.Schema[4].GetConvertedType())
+		pr.ReadStop()
+
+		bAfter, err := NewBowFromParquetReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), ParquetReadOptions{}, false)
+		require.NoError(t, err)
+
+		ExpectEqual(t, bBefore, bAfter)
+		assert.Equal(t, -1, bAfter.Metadata().FindKey(keyParquetColTypesMeta))
+		unit, err := bAfter.GetParquetMetaColTimeUnit(1)
+		require.NoError(t, err)
+		assert.Equal(t, time.Microsecond, unit)
+	})
+
+	t.Run("second timestamps are written as milliseconds", func(t *testing.T) {
+		bBefore, err := NewBowFromRowBasedInterfaces(
+			[]string{"time"},
+			[]Type{TimestampSec},
+			[][]interface{}{{2}, {nil}})
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, bBefore.WriteParquetTo(&buf, ParquetWriteOptions{}, false))
+
+		bAfter, err := NewBowFromParquetReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()), ParquetReadOptions{}, false)
+		require.NoError(t, err)
+
+		assert.Equal(t, TimestampMilli, bAfter.ColumnType(0))
+		assert.Equal(t, arrow.Timestamp(2000), bAfter.GetValue(0, 0))
+		assert.Nil(t, bAfter.GetValue(0, 1))
+	})
+
 	t.Run("write empty bow", func(t *testing.T) {
 		bBefore := NewBowEmpty()
 
@@ -117,7 +472,13 @@ func TestParquet(t *testing.T) {
 		bAfter, err := NewBowFromParquet(testOutputFileName+"_meta.parquet", false)
 		assert.NoError(t, err)
 
-		assert.Equal(t, bBefore.String(), bAfter.String())
+		// the timestamp logical type of the column types metadata is read as a timestamp Type
+		expected, err := NewBowWithMetadata(
+			NewMetadata(keys, values),
+			NewSeries("time", TimestampMicro, []arrow.Timestamp{0}, []bool{true}),
+			series[1])
+		require.NoError(t, err)
+		assert.Equal(t, expected.String(), bAfter.String())
 
 		require.NoError(t, os.Remove(testOutputFileName+"_meta.parquet"))
 	})
@@ -0,0 +1,281 @@
+package bow
+
+import (
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+// parquetDatasetNullPartition is the directory value of the nil partition values, as used by Hive.
+const parquetDatasetNullPartition = "__HIVE_DEFAULT_PARTITION__"
+
+// parquetDatasetFileName is the name of the parquet file written in each partition directory.
+const parquetDatasetFileName = "part-0.parquet"
+
+// WriteParquetDataset writes a Bow to the directory `dir` as a parquet dataset partitioned
+// by the columns `partitionColNames`, in Hive-style directories such as `dir/site=X/day=2024-01-01/part-0.parquet`.
+// The partition columns are not written in the parquet files, and their nil values are written as `__HIVE_DEFAULT_PARTITION__`.
+// Returns an error if a partition value is the string `__HIVE_DEFAULT_PARTITION__`, which would be read back as nil.
+// Existing files of the written partitions are overwritten.
+// Argument verbose is used to print information about the written files.
+func (b *bow) WriteParquetDataset(dir string, partitionColNames []string, options ParquetWriteOptions, verbose bool) error {
+	if len(partitionColNames) == 0 {
+		return fmt.Errorf("missing partition columns")
+	}
+
+	partitionColIndices := make([]int, len(partitionColNames))
+	isPartitionCol := make(map[int]bool, len(partitionColNames))
+	for i, colName := range partitionColNames {
+		colIndex, err := b.ColumnIndex(colName)
+		if err != nil {
+			return err
+		}
+		switch b.ColumnType(colIndex) {
+		case List, Struct, Binary:
+			return fmt.Errorf("unsupported partition type '%s' for column '%s'", b.ColumnType(colIndex), colName)
+		}
+		partitionColIndices[i] = colIndex
+		isPartitionCol[colIndex] = true
+	}
+
+	var valueColIndices []int
+	for colIndex := 0; colIndex < b.NumCols(); colIndex++ {
+		if !isPartitionCol[colIndex] {
+			valueColIndices = append(valueColIndices, colIndex)
+		}
+	}
+	if len(valueColIndices) == 0 {
+		return fmt.Errorf("bow has no column other than the partition columns")
+	}
+
+	// The row indices are grouped by partition in a single pass, in the order of their first row
+	var distinctPartitionDirs []string
+	rowIndicesByPartitionDir := make(map[string][]int)
+	segments := make([]string, len(partitionColIndices))
+	for rowIndex := 0; rowIndex < b.NumRows(); rowIndex++ {
+		for i, colIndex := range partitionColIndices {
+			value := b.formatCSVValue(colIndex, rowIndex, parquetDatasetNullPartition)
+			if value == parquetDatasetNullPartition && b.Column(colIndex).IsValid(rowIndex) {
+				return fmt.Errorf("column '%s': row %d: value '%s' is reserved for nil partition values",
+					b.ColumnName(colIndex), rowIndex, parquetDatasetNullPartition)
+			}
+			segments[i] = url.PathEscape(b.ColumnName(colIndex)) + "=" + url.PathEscape(value)
+		}
+		partitionDir := filepath.Join(segments...)
+		if _, ok := rowIndicesByPartitionDir[partitionDir]; !ok {
+			distinctPartitionDirs = append(distinctPartitionDirs, partitionDir)
+		}
+		rowIndicesByPartitionDir[partitionDir] = append(rowIndicesByPartitionDir[partitionDir], rowIndex)
+	}
+
+	values, err := b.Select(valueColIndices...)
+	if err != nil {
+		return fmt.Errorf("bow.Select: %w", err)
+	}
+
+	for _, partitionDir := range distinctPartitionDirs {
+		partition := values.(*bow).newBowFromRowIndices(rowIndicesByPartitionDir[partitionDir])
+
+		path := filepath.Join(dir, partitionDir)
+		if err = os.MkdirAll(path, 0o755); err != nil {
+			return fmt.Errorf("os.MkdirAll: %w", err)
+		}
+
+		if err = writeParquetDatasetFile(partition, filepath.Join(path, parquetDatasetFileName), options, verbose); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeParquetDatasetFile(b Bow, path string, options ParquetWriteOptions, verbose bool) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("os.Create: %w", err)
+	}
+
+	if err = b.WriteParquetTo(f, options, verbose); err != nil {
+		_ = f.Close()
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("os.File.Close: %w", err)
+	}
+
+	return nil
+}
+
+// ParquetDatasetOptions are options to read a partitioned parquet dataset:
+// - ReadOptions: options used to read each parquet file, its ColNames only applying to the columns of the files
+// - PartitionTypes: types of the partition columns by name, missing types being inferred from the partition values
+// in the same way as CSV values
+// - PartitionFilter: returns whether to read the files of a partition, from its values by partition column name,
+// converted to the partition column types, defaults to reading all the partitions
+type ParquetDatasetOptions struct {
+	ReadOptions     ParquetReadOptions
+	PartitionTypes  map[string]Type
+	PartitionFilter func(partition map[string]interface{}) bool
+}
+
+// parquetDatasetFile is a parquet file of a dataset with the raw values of its partition.
+type parquetDatasetFile struct {
+	path   string
+	values []interface{}
+}
+
+// NewBowFromParquetDataset loads the parquet dataset partitioned in Hive-style directories under `dir`,
+// as written by Bow.WriteParquetDataset, returning a new Bow.
+// The partition columns are added after the columns of the files, which are read in the lexical order of their paths.
+// Files and directories whose name starts with `.` or `_` are ignored.
+// Argument verbose is used to print information about the files loaded.
+func NewBowFromParquetDataset(dir string, options ParquetDatasetOptions, verbose bool) (Bow, error) {
+	var partitionColNames []string
+	var files []parquetDatasetFile
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path != dir && (strings.HasPrefix(d.Name(), ".") || strings.HasPrefix(d.Name(), "_")) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".parquet") {
+			return nil
+		}
+
+		relDir, err := filepath.Rel(dir, filepath.Dir(path))
+		if err != nil {
+			return fmt.Errorf("filepath.Rel: %w", err)
+		}
+
+		var colNames []string
+		var values []interface{}
+		if relDir != "." {
+			for _, segment := range strings.Split(relDir, string(filepath.Separator)) {
+				colName, value, err := parseParquetDatasetSegment(segment)
+				if err != nil {
+					return fmt.Errorf("file '%s': %w", path, err)
+				}
+				colNames = append(colNames, colName)
+				values = append(values, value)
+			}
+		}
+
+		if files == nil {
+			partitionColNames = colNames
+		} else if !reflect.DeepEqual(colNames, partitionColNames) {
+			return fmt.Errorf("file '%s' has partition columns %v instead of %v", path, colNames, partitionColNames)
+		}
+
+		files = append(files, parquetDatasetFile{path: path, values: values})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("filepath.WalkDir: %w", err)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no parquet file found in '%s'", dir)
+	}
+
+	partitionTypes := make([]Type, len(partitionColNames))
+	for i, colName := range partitionColNames {
+		partitionTypes[i] = options.PartitionTypes[colName]
+		if partitionTypes[i] != Unknown {
+			continue
+		}
+		values := make([]interface{}, len(files))
+		for j, file := range files {
+			values[j] = file.values[i]
+		}
+		partitionTypes[i] = getBowTypeFromCSVValues(values)
+	}
+
+	var bows []Bow
+	for _, file := range files {
+		if options.PartitionFilter != nil {
+			partition := make(map[string]interface{}, len(partitionColNames))
+			for i, colName := range partitionColNames {
+				partition[colName] = partitionTypes[i].Convert(file.values[i])
+			}
+			if !options.PartitionFilter(partition) {
+				continue
+			}
+		}
+
+		b, err := NewBowFromParquetWithOptions(file.path, options.ReadOptions, verbose)
+		if err != nil {
+			return nil, fmt.Errorf("file '%s': %w", file.path, err)
+		}
+
+		if b, err = addParquetDatasetPartitionCols(b, partitionColNames, partitionTypes, file.values); err != nil {
+			return nil, fmt.Errorf("file '%s': %w", file.path, err)
+		}
+		bows = append(bows, b)
+	}
+
+	if len(bows) == 0 {
+		// the schema is read from the first file when no partition is selected
+		r, err := NewParquetReader(files[0].path, options.ReadOptions, false)
+		if err != nil {
+			return nil, fmt.Errorf("file '%s': %w", files[0].path, err)
+		}
+		defer r.Close()
+
+		b, err := r.read(0)
+		if err != nil {
+			return nil, fmt.Errorf("file '%s': %w", files[0].path, err)
+		}
+		return addParquetDatasetPartitionCols(b, partitionColNames, partitionTypes, files[0].values)
+	}
+
+	return AppendBows(bows...)
+}
+
+// parseParquetDatasetSegment returns the column name and the value of a `name=value` directory,
+// the value being nil for the Hive default partition.
+func parseParquetDatasetSegment(segment string) (string, interface{}, error) {
+	escapedName, escapedValue, found := strings.Cut(segment, "=")
+	if !found {
+		return "", nil, fmt.Errorf("directory '%s' is not a partition", segment)
+	}
+
+	colName, err := url.PathUnescape(escapedName)
+	if err != nil {
+		return "", nil, fmt.Errorf("url.PathUnescape: %w", err)
+	}
+
+	if escapedValue == parquetDatasetNullPartition {
+		return colName, nil, nil
+	}
+
+	value, err := url.PathUnescape(escapedValue)
+	if err != nil {
+		return "", nil, fmt.Errorf("url.PathUnescape: %w", err)
+	}
+
+	return colName, value, nil
+}
+
+func addParquetDatasetPartitionCols(b Bow, colNames []string, types []Type, values []interface{}) (Bow, error) {
+	series := make([]Series, len(colNames))
+	for i, colName := range colNames {
+		buf := NewBuffer(b.NumRows(), types[i])
+		if values[i] != nil {
+			for rowIndex := 0; rowIndex < b.NumRows(); rowIndex++ {
+				buf.SetOrDrop(rowIndex, values[i])
+			}
+		}
+		series[i] = NewSeriesFromBuffer(colName, buf)
+	}
+
+	return b.AddCols(series...)
+}
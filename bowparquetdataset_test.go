@@ -0,0 +1,134 @@
+package bow
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParquetDataset(t *testing.T) {
+	bBefore, err := NewBowFromRowBasedInterfaces(
+		[]string{"time", "value", "site", "day"},
+		[]Type{Int64, Float64, String, Date},
+		[][]interface{}{
+			{1, 1., "a", "2024-01-01"},
+			{2, 2., "b", "2024-01-01"},
+			{3, 3., "a", "2024-01-02"},
+			{4, 4., "a", "2024-01-01"},
+			{5, 5., "b/c", nil},
+		})
+	require.NoError(t, err)
+	bBefore = bBefore.SetMetadata("source", "dataset")
+
+	dir := t.TempDir()
+	require.NoError(t, bBefore.WriteParquetDataset(dir, []string{"site", "day"}, ParquetWriteOptions{}, false))
+
+	t.Run("hive directories", func(t *testing.T) {
+		for _, path := range []string{
+			"site=a/day=2024-01-01/part-0.parquet",
+			"site=a/day=2024-01-02/part-0.parquet",
+			"site=b/day=2024-01-01/part-0.parquet",
+			"site=b%2Fc/day=__HIVE_DEFAULT_PARTITION__/part-0.parquet",
+		} {
+			assert.FileExists(t, filepath.Join(dir, path))
+		}
+
+		b, err := NewBowFromParquet(filepath.Join(dir, "site=a/day=2024-01-01/part-0.parquet"), false)
+		require.NoError(t, err)
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"time", "value"},
+			[]Type{Int64, Float64},
+			[][]interface{}{
+				{1, 1.},
+				{4, 4.},
+			})
+		require.NoError(t, err)
+		expected = expected.SetMetadata("source", "dataset")
+		ExpectEqual(t, expected, b)
+	})
+
+	t.Run("read all partitions", func(t *testing.T) {
+		b, err := NewBowFromParquetDataset(dir, ParquetDatasetOptions{}, false)
+		require.NoError(t, err)
+
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"time", "value", "site", "day"},
+			[]Type{Int64, Float64, String, Date},
+			[][]interface{}{
+				{1, 1., "a", "2024-01-01"},
+				{4, 4., "a", "2024-01-01"},
+				{3, 3., "a", "2024-01-02"},
+				{2, 2., "b", "2024-01-01"},
+				{5, 5., "b/c", nil},
+			})
+		require.NoError(t, err)
+		expected = expected.SetMetadata("source", "dataset")
+		ExpectEqual(t, expected, b)
+	})
+
+	t.Run("partition filter and types", func(t *testing.T) {
+		b, err := NewBowFromParquetDataset(dir, ParquetDatasetOptions{
+			ReadOptions:    ParquetReadOptions{ColNames: []string{"value"}},
+			PartitionTypes: map[string]Type{"site": Dictionary},
+			PartitionFilter: func(partition map[string]interface{}) bool {
+				day, ok := ToDate("2024-01-01")
+				return ok && partition["day"] == day
+			},
+		}, false)
+		require.NoError(t, err)
+
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"value", "site", "day"},
+			[]Type{Float64, Dictionary, Date},
+			[][]interface{}{
+				{1., "a", "2024-01-01"},
+				{4., "a", "2024-01-01"},
+				{2., "b", "2024-01-01"},
+			})
+		require.NoError(t, err)
+		expected = expected.SetMetadata("source", "dataset")
+		ExpectEqual(t, expected, b)
+	})
+
+	t.Run("no partition selected", func(t *testing.T) {
+		b, err := NewBowFromParquetDataset(dir, ParquetDatasetOptions{
+			PartitionFilter: func(map[string]interface{}) bool { return false },
+		}, false)
+		require.NoError(t, err)
+		assert.Equal(t, 0, b.NumRows())
+		assert.Equal(t, 4, b.NumCols())
+		assert.Equal(t, Date, b.ColumnType(3))
+	})
+
+	t.Run("inconsistent partitions", func(t *testing.T) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "site=d"), 0o755))
+		values, err := bBefore.Select(0, 1)
+		require.NoError(t, err)
+		require.NoError(t, values.WriteParquet(filepath.Join(dir, "site=d", "part-0.parquet"), false))
+		defer os.RemoveAll(filepath.Join(dir, "site=d"))
+
+		_, err = NewBowFromParquetDataset(dir, ParquetDatasetOptions{}, false)
+		assert.Error(t, err)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		assert.Error(t, bBefore.WriteParquetDataset(t.TempDir(), nil, ParquetWriteOptions{}, false))
+		assert.Error(t, bBefore.WriteParquetDataset(t.TempDir(), []string{"unknown"}, ParquetWriteOptions{}, false))
+
+		reserved, err := NewBowFromRowBasedInterfaces(
+			[]string{"value", "site"},
+			[]Type{Float64, String},
+			[][]interface{}{
+				{1., "a"},
+				{2., parquetDatasetNullPartition},
+			})
+		require.NoError(t, err)
+		assert.Error(t, reserved.WriteParquetDataset(t.TempDir(), []string{"site"}, ParquetWriteOptions{}, false))
+
+		_, err = NewBowFromParquetDataset(t.TempDir(), ParquetDatasetOptions{}, false)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,310 @@
+package bow
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/xitongsys/parquet-go-source/local"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+	"github.com/xitongsys/parquet-go/schema"
+	"github.com/xitongsys/parquet-go/source"
+)
+
+// ParquetReader reads parquet data chunk by chunk, allowing to process large files without loading them whole.
+// All the Bows returned by Next share the same schema and Metadata.
+type ParquetReader struct {
+	pr       *reader.ParquetReader
+	cols     []parquetColumn
+	metadata Metadata
+	footer   string
+
+	batchSize     int64
+	numRowsRead   int64
+	rowGroupIndex int
+}
+
+// parquetColumn describes how to read a parquet value column into a Series.
+type parquetColumn struct {
+	name          string
+	valueColIndex int64
+	typ           Type
+	isDecimal     bool
+	decimalScale  int32
+}
+
+// NewParquetReader opens the parquet file at `path` with the ParquetReadOptions `options`,
+// returning a new ParquetReader to be closed after use.
+// Argument verbose is used to print information about the file opened.
+func NewParquetReader(path string, options ParquetReadOptions, verbose bool) (*ParquetReader, error) {
+	fr, err := local.NewLocalFileReader(path)
+	if err != nil {
+		return nil, fmt.Errorf("local.NewLocalFileReader: %w", err)
+	}
+
+	r, err := newParquetReader(fr, options)
+	if err != nil {
+		_ = fr.Close()
+		return nil, err
+	}
+
+	if verbose {
+		fmt.Printf("bow.NewParquetReader: %s successfully opened: %d rows\n%+v\n",
+			path, r.pr.GetNumRows(), r.footer)
+	}
+
+	return r, nil
+}
+
+// NewParquetReaderAt opens the parquet data of `size` bytes from `r` with the ParquetReadOptions `options`,
+// returning a new ParquetReader to be closed after use.
+// Argument verbose is used to print information about the data opened.
+func NewParquetReaderAt(r io.ReaderAt, size int64, options ParquetReadOptions, verbose bool) (*ParquetReader, error) {
+	res, err := newParquetReader(newReaderAtParquetFile(r, size), options)
+	if err != nil {
+		return nil, err
+	}
+
+	if verbose {
+		fmt.Printf("bow.NewParquetReaderAt: reader successfully opened: %d rows\n%+v\n",
+			res.pr.GetNumRows(), res.footer)
+	}
+
+	return res, nil
+}
+
+func newParquetReader(pf source.ParquetFile, options ParquetReadOptions) (*ParquetReader, error) {
+	options.validate()
+
+	pr := new(reader.ParquetReader)
+	pr.NP = 4
+	pr.PFile = pf
+	if err := pr.ReadFooter(); err != nil {
+		return nil, fmt.Errorf("reader.ParquetReader.ReadFooter: %w", err)
+	}
+
+	if err := filterParquetRowGroups(pr.Footer, options.RowGroupPredicates); err != nil {
+		return nil, err
+	}
+
+	footerIndented, err := json.MarshalIndent(pr.Footer, "", "\t")
+	if err != nil {
+		return nil, fmt.Errorf("json.MarshalIndent: %w", err)
+	}
+
+	pr.ColumnBuffers = make(map[string]*reader.ColumnBufferType)
+	pr.SchemaHandler = schema.NewSchemaHandlerFromSchemaList(pr.Footer.GetSchema())
+
+	var originalColNames = make([]string, len(pr.Footer.GetSchema()))
+	for i, se := range pr.Footer.GetSchema() {
+		originalColNames[i] = se.Name
+	}
+
+	pr.RenameSchema()
+
+	selectedColNames := make(map[string]bool, len(options.ColNames))
+	for _, colName := range options.ColNames {
+		selectedColNames[colName] = true
+	}
+
+	binaryColNames := make(map[string]bool)
+	for _, m := range pr.Footer.KeyValueMetadata {
+		if m.GetKey() == keyParquetBinaryColsMeta {
+			var names []string
+			if err = json.Unmarshal([]byte(m.GetValue()), &names); err != nil {
+				return nil, fmt.Errorf("metadata '%s': json.Unmarshal: %w", keyParquetBinaryColsMeta, err)
+			}
+			for _, name := range names {
+				binaryColNames[name] = true
+			}
+		}
+	}
+
+	var valueColIndex int64
+	var cols []parquetColumn
+	var parquetColTypesMetas []parquetColTypesMeta
+	for colIndex, col := range pr.Footer.GetSchema() {
+		if col.NumChildren != nil {
+			continue
+		}
+
+		if options.ColNames != nil && !selectedColNames[originalColNames[colIndex]] {
+			valueColIndex++
+			continue
+		}
+
+		bowType, isInteger := mapParquetIntegerToBowTypes[col.GetConvertedType()]
+		if !isInteger || col.ConvertedType == nil {
+			bowType, isInteger = mapParquetToBowTypes[col.GetType()], false
+		}
+
+		isString := isParquetString(col)
+		if isString {
+			bowType = String
+		}
+
+		isBinary := col.GetType() == parquet.Type_BYTE_ARRAY && col.ConvertedType == nil && col.LogicalType == nil &&
+			binaryColNames[originalColNames[colIndex]]
+		if isBinary {
+			bowType = Binary
+		}
+
+		isEnum := col.GetType() == parquet.Type_BYTE_ARRAY && col.LogicalType != nil && col.LogicalType.IsSetENUM()
+		if isEnum {
+			bowType = Dictionary
+		}
+
+		decimalScale, isDecimal := getParquetDecimalScale(col)
+		if isDecimal {
+			bowType = Decimal
+		}
+
+		timestampType, isTimestamp := getParquetTimestampType(col)
+		if isTimestamp {
+			bowType = timestampType
+		}
+
+		isDate := isParquetDate(col)
+		if isDate {
+			bowType = Date
+		}
+
+		isNative := isInteger || isString || isEnum || isDecimal || isTimestamp || isDate
+		if !isNative && (col.ConvertedType != nil || col.LogicalType != nil) {
+			parquetColTypesMetas = append(parquetColTypesMetas, parquetColTypesMeta{
+				Name:        originalColNames[colIndex],
+				LogicalType: col.LogicalType,
+			})
+		}
+
+		cols = append(cols, parquetColumn{
+			name:          originalColNames[colIndex],
+			valueColIndex: valueColIndex,
+			typ:           bowType,
+			isDecimal:     isDecimal,
+			decimalScale:  decimalScale,
+		})
+		valueColIndex++
+	}
+
+	if options.ColNames != nil {
+		if cols, err = selectParquetColumns(cols, options.ColNames); err != nil {
+			return nil, err
+		}
+	}
+
+	var keys, values []string
+	for _, m := range pr.Footer.KeyValueMetadata {
+		if m.GetKey() != "ARROW:schema" && m.GetKey() != keyParquetColTypesMeta && m.GetKey() != keyParquetBinaryColsMeta {
+			keys = append(keys, m.GetKey())
+			values = append(values, m.GetValue())
+		}
+	}
+
+	if len(parquetColTypesMetas) > 0 {
+		colTypesJSON, err := json.Marshal(parquetColTypesMetas)
+		if err != nil {
+			return nil, fmt.Errorf("json.Marshal: %w", err)
+		}
+		keys = append(keys, keyParquetColTypesMeta)
+		values = append(values, string(colTypesJSON))
+	}
+
+	return &ParquetReader{
+		pr:        pr,
+		cols:      cols,
+		metadata:  NewMetadata(keys, values),
+		footer:    string(footerIndented),
+		batchSize: int64(options.BatchSize),
+	}, nil
+}
+
+// selectParquetColumns returns the columns named `colNames`, in the same order.
+func selectParquetColumns(cols []parquetColumn, colNames []string) ([]parquetColumn, error) {
+	colsByName := make(map[string]parquetColumn, len(cols))
+	for _, col := range cols {
+		colsByName[col.name] = col
+	}
+
+	res := make([]parquetColumn, len(colNames))
+	for i, colName := range colNames {
+		col, ok := colsByName[colName]
+		if !ok {
+			return nil, fmt.Errorf("column '%s' not found", colName)
+		}
+		res[i] = col
+	}
+	return res, nil
+}
+
+// HasNext returns true if the next call to Next will return a new Bow.
+func (r *ParquetReader) HasNext() bool {
+	return r.numRowsRead < r.pr.GetNumRows()
+}
+
+// Next returns a new Bow holding the next row group,
+// or the next BatchSize rows if the option is set.
+// Returns io.EOF if all the rows have been read.
+func (r *ParquetReader) Next() (Bow, error) {
+	if !r.HasNext() {
+		return nil, io.EOF
+	}
+
+	numRows := r.batchSize
+	if numRows == 0 {
+		// empty row groups are skipped, as HasNext guarantees that a non-empty one remains
+		for r.pr.Footer.RowGroups[r.rowGroupIndex].NumRows == 0 {
+			r.rowGroupIndex++
+		}
+		numRows = r.pr.Footer.RowGroups[r.rowGroupIndex].NumRows
+		r.rowGroupIndex++
+	}
+	if remaining := r.pr.GetNumRows() - r.numRowsRead; numRows > remaining {
+		numRows = remaining
+	}
+
+	return r.read(numRows)
+}
+
+// read returns a new Bow holding the next `numRows` rows.
+func (r *ParquetReader) read(numRows int64) (Bow, error) {
+	series := make([]Series, len(r.cols))
+	for i, col := range r.cols {
+		values, _, _, err := r.pr.ReadColumnByIndex(col.valueColIndex, numRows)
+		if err != nil {
+			return nil, fmt.Errorf("reader.ParquetReader.ReadColumnByIndex: %w", err)
+		}
+
+		buf := NewBuffer(len(values), col.typ)
+		for rowIndex, v := range values {
+			if col.isDecimal {
+				d, err := fromParquetDecimalValue(v, col.decimalScale)
+				if err != nil {
+					return nil, fmt.Errorf("column '%s': row %d: %w", col.name, r.numRowsRead+int64(rowIndex), err)
+				}
+				buf.SetOrDrop(rowIndex, d)
+				continue
+			}
+			buf.SetOrDrop(rowIndex, fromParquetValue(v, col.typ))
+		}
+		series[i] = NewSeriesFromBuffer(col.name, buf)
+	}
+	r.numRowsRead += numRows
+
+	b, err := NewBowWithMetadata(r.metadata, series...)
+	if err != nil {
+		return nil, fmt.Errorf("NewBowWithMetadata: %w", err)
+	}
+
+	return b, nil
+}
+
+// Close releases the files opened by the ParquetReader.
+func (r *ParquetReader) Close() error {
+	r.pr.ReadStop()
+	if err := r.pr.PFile.Close(); err != nil {
+		return fmt.Errorf("source.ParquetFile.Close: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package bow
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParquetReader(t *testing.T) {
+	bBefore, err := NewBowFromRowBasedInterfaces(
+		[]string{"time", "value", "name"},
+		[]Type{Int64, Float64, String},
+		[][]interface{}{
+			{1, 1., "a"},
+			{2, nil, "b"},
+			{3, 3., nil},
+			{4, 4., "d"},
+			{5, 5., "e"},
+		})
+	require.NoError(t, err)
+	bBefore = bBefore.SetMetadata("source", "chunks")
+
+	var buf bytes.Buffer
+	require.NoError(t, bBefore.WriteParquetTo(&buf, ParquetWriteOptions{RowGroupSize: 2}, false))
+
+	readAll := func(t *testing.T, r *ParquetReader) []Bow {
+		var res []Bow
+		for r.HasNext() {
+			b, err := r.Next()
+			require.NoError(t, err)
+			assert.Equal(t, bBefore.Metadata(), b.Metadata())
+			res = append(res, b)
+		}
+		_, err := r.Next()
+		assert.ErrorIs(t, err, io.EOF)
+		require.NoError(t, r.Close())
+		return res
+	}
+
+	t.Run("one bow per row group", func(t *testing.T) {
+		r, err := NewParquetReaderAt(bytes.NewReader(buf.Bytes()), int64(buf.Len()), ParquetReadOptions{}, false)
+		require.NoError(t, err)
+
+		bows := readAll(t, r)
+		require.Len(t, bows, 3)
+		assert.Equal(t, 2, bows[0].NumRows())
+		assert.Equal(t, 2, bows[1].NumRows())
+		assert.Equal(t, 1, bows[2].NumRows())
+
+		res, err := AppendBows(bows...)
+		require.NoError(t, err)
+		ExpectEqual(t, bBefore, res)
+	})
+
+	t.Run("batch size", func(t *testing.T) {
+		r, err := NewParquetReaderAt(bytes.NewReader(buf.Bytes()), int64(buf.Len()),
+			ParquetReadOptions{BatchSize: 3}, false)
+		require.NoError(t, err)
+
+		bows := readAll(t, r)
+		require.Len(t, bows, 2)
+		assert.Equal(t, 3, bows[0].NumRows())
+		assert.Equal(t, 2, bows[1].NumRows())
+
+		res, err := AppendBows(bows...)
+		require.NoError(t, err)
+		ExpectEqual(t, bBefore, res)
+	})
+
+	t.Run("projection and row group predicates", func(t *testing.T) {
+		r, err := NewParquetReaderAt(bytes.NewReader(buf.Bytes()), int64(buf.Len()), ParquetReadOptions{
+			ColNames:           []string{"value", "time"},
+			RowGroupPredicates: []ParquetPredicate{{ColName: "time", Operator: ParquetGe, Value: 3}},
+		}, false)
+		require.NoError(t, err)
+
+		bows := readAll(t, r)
+		require.Len(t, bows, 2)
+
+		res, err := AppendBows(bows...)
+		require.NoError(t, err)
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"value", "time"},
+			[]Type{Float64, Int64},
+			[][]interface{}{
+				{3., 3},
+				{4., 4},
+				{5., 5},
+			})
+		require.NoError(t, err)
+		expected = expected.SetMetadata("source", "chunks")
+		ExpectEqual(t, expected, res)
+	})
+
+	t.Run("file", func(t *testing.T) {
+		path := testOutputFileName + "_reader.parquet"
+		require.NoError(t, bBefore.WriteParquet(path, false))
+		defer os.Remove(path)
+
+		r, err := NewParquetReader(path, ParquetReadOptions{}, false)
+		require.NoError(t, err)
+
+		res, err := AppendBows(readAll(t, r)...)
+		require.NoError(t, err)
+		ExpectEqual(t, bBefore, res)
+	})
+
+	t.Run("empty bow", func(t *testing.T) {
+		empty, err := NewBowFromRowBasedInterfaces([]string{"time"}, []Type{Int64}, nil)
+		require.NoError(t, err)
+		var emptyBuf bytes.Buffer
+		require.NoError(t, empty.WriteParquetTo(&emptyBuf, ParquetWriteOptions{}, false))
+
+		r, err := NewParquetReaderAt(bytes.NewReader(emptyBuf.Bytes()), int64(emptyBuf.Len()), ParquetReadOptions{}, false)
+		require.NoError(t, err)
+		assert.False(t, r.HasNext())
+		require.NoError(t, r.Close())
+	})
+}
@@ -0,0 +1,473 @@
+package bow
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/bitutil"
+)
+
+// Predicate is a condition on the rows of a Bow, used by Bow.FilterWhere.
+// Predicates are evaluated column by column on the typed arrow arrays into a selection bitmap,
+// without calling a function nor boxing a value for each row.
+// Rows with a nil value never match a comparison, and always match its negation with NoneOf.
+// Use Bow.Filter with RowCmp functions for conditions not covered by the Predicates.
+type Predicate interface {
+	// eval returns the selection bitmap of the rows of `b` matching the Predicate.
+	eval(b *bow) ([]byte, error)
+}
+
+type compareOperator int
+
+const (
+	opEq compareOperator = iota
+	opNe
+	opLt
+	opLe
+	opGt
+	opGe
+)
+
+func (op compareOperator) String() string {
+	return [...]string{"=", "!=", "<", "<=", ">", ">="}[op]
+}
+
+// cmpUnordered is the result of the comparison of a NaN value with any other value.
+const cmpUnordered = 2
+
+// match returns whether the result `cmp` of the comparison of a row value with the Predicate value matches the operator.
+// Unordered values, such as NaN, only match opNe, as with the Go comparison operators.
+func (op compareOperator) match(cmp int) bool {
+	if cmp == cmpUnordered {
+		return op == opNe
+	}
+	switch op {
+	case opEq:
+		return cmp == 0
+	case opNe:
+		return cmp != 0
+	case opLt:
+		return cmp < 0
+	case opLe:
+		return cmp <= 0
+	case opGt:
+		return cmp > 0
+	default:
+		return cmp >= 0
+	}
+}
+
+type comparePredicate struct {
+	colIndex int
+	op       compareOperator
+	value    interface{}
+}
+
+// ColEq returns a Predicate matching the rows whose value in the column `colIndex` is equal to `value`.
+// As with MakeFilterValues, `value` is converted to the Type of the column.
+func ColEq(colIndex int, value interface{}) Predicate {
+	return comparePredicate{colIndex: colIndex, op: opEq, value: value}
+}
+
+// ColNe returns a Predicate matching the rows whose value in the column `colIndex` is not nil and different from `value`.
+func ColNe(colIndex int, value interface{}) Predicate {
+	return comparePredicate{colIndex: colIndex, op: opNe, value: value}
+}
+
+// ColLt returns a Predicate matching the rows whose value in the column `colIndex` is lower than `value`.
+func ColLt(colIndex int, value interface{}) Predicate {
+	return comparePredicate{colIndex: colIndex, op: opLt, value: value}
+}
+
+// ColLe returns a Predicate matching the rows whose value in the column `colIndex` is lower than or equal to `value`.
+func ColLe(colIndex int, value interface{}) Predicate {
+	return comparePredicate{colIndex: colIndex, op: opLe, value: value}
+}
+
+// ColGt returns a Predicate matching the rows whose value in the column `colIndex` is greater than `value`.
+func ColGt(colIndex int, value interface{}) Predicate {
+	return comparePredicate{colIndex: colIndex, op: opGt, value: value}
+}
+
+// ColGe returns a Predicate matching the rows whose value in the column `colIndex` is greater than or equal to `value`.
+func ColGe(colIndex int, value interface{}) Predicate {
+	return comparePredicate{colIndex: colIndex, op: opGe, value: value}
+}
+
+// ColBetween returns a Predicate matching the rows whose value in the column `colIndex` is between `min` and `max` included.
+func ColBetween(colIndex int, min, max interface{}) Predicate {
+	return AllOf(ColGe(colIndex, min), ColLe(colIndex, max))
+}
+
+func (p comparePredicate) eval(b *bow) ([]byte, error) {
+	if err := b.validatePredicateCol(p.colIndex); err != nil {
+		return nil, err
+	}
+
+	typ := b.ColumnType(p.colIndex)
+	value := typ.Convert(p.value)
+	if value == nil {
+		return nil, fmt.Errorf("column '%s': value '%v' is not convertible to type '%s'",
+			b.ColumnName(p.colIndex), p.value, typ)
+	}
+
+	res := newSelection(b.NumRows())
+	col := b.Column(p.colIndex)
+	switch typ {
+	case Int64:
+		v := value.(int64)
+		for i, x := range array.NewInt64Data(col.Data()).Int64Values() {
+			if col.IsValid(i) && p.op.match(compareInt64(x, v)) {
+				bitutil.SetBit(res, i)
+			}
+		}
+	case Float64:
+		v := value.(float64)
+		for i, x := range array.NewFloat64Data(col.Data()).Float64Values() {
+			if col.IsValid(i) && p.op.match(compareFloat64(x, v)) {
+				bitutil.SetBit(res, i)
+			}
+		}
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		v := int64(value.(arrow.Timestamp))
+		for i, x := range array.NewTimestampData(col.Data()).TimestampValues() {
+			if col.IsValid(i) && p.op.match(compareInt64(int64(x), v)) {
+				bitutil.SetBit(res, i)
+			}
+		}
+	case String:
+		v := value.(string)
+		arr := array.NewStringData(col.Data())
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsValid(i) && p.op.match(compareString(arr.Value(i), v)) {
+				bitutil.SetBit(res, i)
+			}
+		}
+	case Dictionary:
+		// The dictionary values are compared once, then rows are selected on their codes
+		v := value.(string)
+		arr := col.(*array.Dictionary)
+		dict := arr.Dictionary().(*array.String)
+		isCodeMatching := make([]bool, dict.Len())
+		for code := range isCodeMatching {
+			isCodeMatching[code] = dict.IsValid(code) && p.op.match(compareString(dict.Value(code), v))
+		}
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsValid(i) && isCodeMatching[arr.GetValueIndex(i)] {
+				bitutil.SetBit(res, i)
+			}
+		}
+	default:
+		buf := b.NewBufferFromCol(p.colIndex)
+		for i := 0; i < buf.Len(); i++ {
+			if buf.IsValid(i) && p.op.match(compareBufferValue(buf, i, value)) {
+				bitutil.SetBit(res, i)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+type nullPredicate struct {
+	colIndex int
+	isNull   bool
+}
+
+// ColIsNull returns a Predicate matching the rows whose value in the column `colIndex` is nil.
+func ColIsNull(colIndex int) Predicate {
+	return nullPredicate{colIndex: colIndex, isNull: true}
+}
+
+// ColIsNotNull returns a Predicate matching the rows whose value in the column `colIndex` is not nil.
+func ColIsNotNull(colIndex int) Predicate {
+	return nullPredicate{colIndex: colIndex}
+}
+
+func (p nullPredicate) eval(b *bow) ([]byte, error) {
+	if err := b.validatePredicateCol(p.colIndex); err != nil {
+		return nil, err
+	}
+
+	res := newSelection(b.NumRows())
+	col := b.Column(p.colIndex)
+	for i := 0; i < col.Len(); i++ {
+		if col.IsNull(i) == p.isNull {
+			bitutil.SetBit(res, i)
+		}
+	}
+	return res, nil
+}
+
+type inPredicate struct {
+	colIndex int
+	values   []interface{}
+}
+
+// ColIn returns a Predicate matching the rows whose value in the column `colIndex` is one of `values`,
+// a nil value matching the nil rows.
+// As with MakeFilterValues, `values` are converted to the Type of the column, the non-convertible ones being ignored.
+func ColIn(colIndex int, values ...interface{}) Predicate {
+	return inPredicate{colIndex: colIndex, values: values}
+}
+
+func (p inPredicate) eval(b *bow) ([]byte, error) {
+	if err := b.validatePredicateCol(p.colIndex); err != nil {
+		return nil, err
+	}
+
+	typ := b.ColumnType(p.colIndex)
+	var values []interface{}
+	var matchNull bool
+	for _, value := range p.values {
+		if value == nil {
+			matchNull = true
+			continue
+		}
+		if value = typ.Convert(value); value != nil {
+			values = append(values, value)
+		}
+	}
+
+	res := newSelection(b.NumRows())
+	col := b.Column(p.colIndex)
+	switch typ {
+	case Int64:
+		set := make(map[int64]struct{}, len(values))
+		for _, value := range values {
+			set[value.(int64)] = struct{}{}
+		}
+		for i, x := range array.NewInt64Data(col.Data()).Int64Values() {
+			if _, ok := set[x]; ok && col.IsValid(i) {
+				bitutil.SetBit(res, i)
+			}
+		}
+	case String:
+		set := make(map[string]struct{}, len(values))
+		for _, value := range values {
+			set[value.(string)] = struct{}{}
+		}
+		arr := array.NewStringData(col.Data())
+		for i := 0; i < arr.Len(); i++ {
+			if _, ok := set[arr.Value(i)]; ok && arr.IsValid(i) {
+				bitutil.SetBit(res, i)
+			}
+		}
+	case Dictionary:
+		arr := col.(*array.Dictionary)
+		codes := dictionaryCodes(arr, values...)
+		for i := 0; i < arr.Len(); i++ {
+			if arr.IsValid(i) {
+				if _, ok := codes[arr.GetValueIndex(i)]; ok {
+					bitutil.SetBit(res, i)
+				}
+			}
+		}
+	default:
+		buf := b.NewBufferFromCol(p.colIndex)
+		for i := 0; i < buf.Len(); i++ {
+			if !buf.IsValid(i) {
+				continue
+			}
+			for _, value := range values {
+				if compareBufferValue(buf, i, value) == 0 {
+					bitutil.SetBit(res, i)
+					break
+				}
+			}
+		}
+	}
+
+	if matchNull {
+		for i := 0; i < col.Len(); i++ {
+			if col.IsNull(i) {
+				bitutil.SetBit(res, i)
+			}
+		}
+	}
+
+	return res, nil
+}
+
+type combinePredicate struct {
+	preds []Predicate
+	isAny bool
+	isNot bool
+}
+
+// AllOf returns a Predicate matching the rows matching all the `preds`, or all the rows if there is none.
+func AllOf(preds ...Predicate) Predicate {
+	return combinePredicate{preds: preds}
+}
+
+// AnyOf returns a Predicate matching the rows matching at least one of the `preds`.
+func AnyOf(preds ...Predicate) Predicate {
+	return combinePredicate{preds: preds, isAny: true}
+}
+
+// NoneOf returns a Predicate matching the rows matching none of the `preds`, such as NoneOf(p) is the negation of p.
+func NoneOf(preds ...Predicate) Predicate {
+	return combinePredicate{preds: preds, isAny: true, isNot: true}
+}
+
+func (p combinePredicate) eval(b *bow) ([]byte, error) {
+	res := newSelection(b.NumRows())
+	if !p.isAny {
+		for i := range res {
+			res[i] = 0xFF
+		}
+	}
+
+	for _, pred := range p.preds {
+		if pred == nil {
+			return nil, fmt.Errorf("nil predicate")
+		}
+		selection, err := pred.eval(b)
+		if err != nil {
+			return nil, err
+		}
+		for i := range res {
+			if p.isAny {
+				res[i] |= selection[i]
+			} else {
+				res[i] &= selection[i]
+			}
+		}
+	}
+
+	if p.isNot {
+		for i := range res {
+			res[i] = ^res[i]
+		}
+	}
+
+	return res, nil
+}
+
+// FilterWhere returns a new Bow with the rows matching the Predicate `pred`, evaluated column by column.
+// If the matching rows are concomitant, the new Bow is a slice of the original one without copy.
+func (b *bow) FilterWhere(pred Predicate) (Bow, error) {
+	if pred == nil {
+		return nil, fmt.Errorf("nil predicate")
+	}
+
+	selection, err := pred.eval(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var indices []int
+	for i := 0; i < b.NumRows(); i++ {
+		if bitutil.BitIsSet(selection, i) {
+			indices = append(indices, i)
+		}
+	}
+
+	return b.newBowFromRowIndices(indices), nil
+}
+
+func (b *bow) validatePredicateCol(colIndex int) error {
+	if colIndex < 0 || colIndex >= b.NumCols() {
+		return fmt.Errorf("column index %d out of bound", colIndex)
+	}
+	return nil
+}
+
+// newSelection returns a new selection bitmap of `numRows` bits, with no row selected.
+func newSelection(numRows int) []byte {
+	return make([]byte, bitutil.CeilByte(numRows)/8)
+}
+
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareFloat64 returns cmpUnordered if any of `a` and `b` is NaN.
+func compareFloat64(a, b float64) int {
+	switch {
+	case math.IsNaN(a) || math.IsNaN(b):
+		return cmpUnordered
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+func compareString(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareBufferValue returns -1, 0 or 1 if the value of `buf` at row `i` is lower than, equal to or greater than `value`,
+// of the Type of the Buffer, following the order of Buffer.Less.
+func compareBufferValue(buf Buffer, i int, value interface{}) int {
+	switch data := buf.Data.(type) {
+	case []int64:
+		return compareInt64(data[i], value.(int64))
+	case []float64:
+		return compareFloat64(data[i], value.(float64))
+	case []bool:
+		return compareInt64(boolToInt64(data[i]), boolToInt64(value.(bool)))
+	case []string:
+		return compareString(data[i], value.(string))
+	case []arrow.Timestamp:
+		return compareInt64(int64(data[i]), int64(value.(arrow.Timestamp)))
+	case []int8:
+		return compareInt64(int64(data[i]), int64(value.(int8)))
+	case []int16:
+		return compareInt64(int64(data[i]), int64(value.(int16)))
+	case []int32:
+		return compareInt64(int64(data[i]), int64(value.(int32)))
+	case []uint8:
+		return compareInt64(int64(data[i]), int64(value.(uint8)))
+	case []uint16:
+		return compareInt64(int64(data[i]), int64(value.(uint16)))
+	case []uint32:
+		return compareInt64(int64(data[i]), int64(value.(uint32)))
+	case []uint64:
+		v := value.(uint64)
+		switch {
+		case data[i] < v:
+			return -1
+		case data[i] > v:
+			return 1
+		}
+		return 0
+	case []float32:
+		return compareFloat64(float64(data[i]), float64(value.(float32)))
+	case []Decimal128:
+		return data[i].Cmp(value.(Decimal128))
+	case [][]byte:
+		return bytes.Compare(data[i], value.([]byte))
+	case []arrow.Date32:
+		return compareInt64(int64(data[i]), int64(value.(arrow.Date32)))
+	case []arrow.Duration:
+		return compareInt64(int64(data[i]), int64(value.(arrow.Duration)))
+	default:
+		// Nested values have no natural order, their string representations are compared instead
+		return compareString(fmt.Sprint(buf.GetValue(i)), fmt.Sprint(value))
+	}
+}
+
+func boolToInt64(v bool) int64 {
+	if v {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,140 @@
+package bow
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBow_FilterWhere(t *testing.T) {
+	b, err := NewBowFromRowBasedInterfaces(
+		[]string{"int", "float", "string", "dict", "time", "date"},
+		[]Type{Int64, Float64, String, Dictionary, TimestampMilli, Date},
+		[][]interface{}{
+			{1, 1.5, "a", "x", 10, "2024-01-01"},
+			{2, nil, "b", "y", 20, "2024-01-02"},
+			{nil, 3.5, nil, nil, nil, nil},
+			{4, 4.5, "d", "x", 40, "2024-01-04"},
+			{5, 5.5, "e", "z", 50, "2024-01-05"},
+		})
+	require.NoError(t, err)
+	b = b.SetMetadata("k", "v")
+
+	for _, testCase := range []struct {
+		name string
+		pred Predicate
+		rows []int
+	}{
+		{name: "int eq", pred: ColEq(0, 2), rows: []int{1}},
+		{name: "int ne", pred: ColNe(0, 2), rows: []int{0, 3, 4}},
+		{name: "int lt", pred: ColLt(0, 4), rows: []int{0, 1}},
+		{name: "float le", pred: ColLe(1, 4.5), rows: []int{0, 2, 3}},
+		{name: "string gt", pred: ColGt(2, "b"), rows: []int{3, 4}},
+		{name: "dictionary ge", pred: ColGe(3, "y"), rows: []int{1, 4}},
+		{name: "timestamp between", pred: ColBetween(4, 20, 40), rows: []int{1, 3}},
+		{name: "date between", pred: ColBetween(5, "2024-01-02", "2024-01-04"), rows: []int{1, 3}},
+		{name: "is null", pred: ColIsNull(1), rows: []int{1}},
+		{name: "is not null", pred: ColIsNotNull(2), rows: []int{0, 1, 3, 4}},
+		{name: "int in", pred: ColIn(0, 1, 5, "not an int"), rows: []int{0, 4}},
+		{name: "string in with nil", pred: ColIn(2, "b", nil), rows: []int{1, 2}},
+		{name: "dictionary in", pred: ColIn(3, "x", "z"), rows: []int{0, 3, 4}},
+		{name: "date in", pred: ColIn(5, "2024-01-05"), rows: []int{4}},
+		{name: "all of", pred: AllOf(ColGe(0, 2), ColIsNotNull(1)), rows: []int{3, 4}},
+		{name: "any of", pred: AnyOf(ColEq(0, 1), ColIsNull(1)), rows: []int{0, 1}},
+		{name: "none of", pred: NoneOf(ColEq(3, "x")), rows: []int{1, 2, 4}},
+		{name: "nested", pred: AllOf(ColGt(1, 1.), NoneOf(AnyOf(ColIsNull(0), ColEq(0, 5)))), rows: []int{0, 3}},
+		{name: "all of nothing", pred: AllOf(), rows: []int{0, 1, 2, 3, 4}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := b.FilterWhere(testCase.pred)
+			require.NoError(t, err)
+
+			expected := b.Filter(func(b Bow, i int) bool {
+				for _, row := range testCase.rows {
+					if row == i {
+						return true
+					}
+				}
+				return false
+			})
+			ExpectEqual(t, expected, res)
+		})
+	}
+
+	t.Run("no row", func(t *testing.T) {
+		res, err := b.FilterWhere(ColGt(0, 10))
+		require.NoError(t, err)
+		assert.True(t, res.Equal(b.NewEmptySlice()), "have: %v", res)
+	})
+
+	t.Run("NaN values only match ne", func(t *testing.T) {
+		nan, err := NewBowFromColBasedInterfaces([]string{"float"}, []Type{Float64},
+			[][]interface{}{{5., math.NaN(), 6.}})
+		require.NoError(t, err)
+
+		for _, testCase := range []struct {
+			name string
+			pred Predicate
+			rows []int
+		}{
+			{name: "eq", pred: ColEq(0, 5.), rows: []int{0}},
+			{name: "ne", pred: ColNe(0, 5.), rows: []int{1, 2}},
+			{name: "le", pred: ColLe(0, 6.), rows: []int{0, 2}},
+			{name: "ge", pred: ColGe(0, 5.), rows: []int{0, 2}},
+			{name: "between", pred: ColBetween(0, 5., 6.), rows: []int{0, 2}},
+			{name: "in", pred: ColIn(0, 5., math.NaN()), rows: []int{0}},
+			{name: "compared to NaN", pred: ColLe(0, math.NaN()), rows: []int{}},
+		} {
+			t.Run(testCase.name, func(t *testing.T) {
+				res, err := nan.FilterWhere(testCase.pred)
+				require.NoError(t, err)
+				require.Equal(t, len(testCase.rows), res.NumRows())
+				for i, row := range testCase.rows {
+					assert.Equal(t, fmt.Sprint(nan.GetValue(0, row)), fmt.Sprint(res.GetValue(0, i)))
+				}
+			})
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		for name, pred := range map[string]Predicate{
+			"nil":                nil,
+			"nil in combination": AnyOf(nil),
+			"out of bound":       ColEq(6, 1),
+			"not convertible":    ColLt(0, "not an int"),
+			"nil value":          ColEq(0, nil),
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := b.FilterWhere(pred)
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func BenchmarkBow_FilterWhere(b *testing.B) {
+	for rows := 10; rows <= 100000; rows *= 10 {
+		data, err := NewBowFromParquet(fmt.Sprintf(
+			"%sbow1-%d-rows.parquet", benchmarkBowsDirPath, rows), false)
+		require.NoError(b, err)
+
+		b.Run(fmt.Sprintf("Predicate_%d_rows", rows), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, err = data.FilterWhere(AllOf(ColGt(2, 4), ColIsNotNull(3)))
+				require.NoError(b, err)
+			}
+		})
+
+		b.Run(fmt.Sprintf("RowCmp_%d_rows", rows), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				data.Filter(func(b Bow, i int) bool {
+					v, ok := b.GetInt64(2, i)
+					return ok && v > 4 && b.GetValue(3, i) != nil
+				})
+			}
+		})
+	}
+}
@@ -24,7 +24,11 @@ func newRecord(metadata Metadata, series ...Series) (arrow.Record, error) {
 		if s.Name == "" {
 			return nil, errors.New("empty Series name")
 		}
-		if getBowTypeFromArrowFingerprint(s.Array.DataType().Fingerprint()) == Unknown {
+		s, err := rescaleDecimalSeries(s)
+		if err != nil {
+			return nil, err
+		}
+		if getBowTypeFromArrowType(s.Array.DataType()) == Unknown {
 			return nil, fmt.Errorf("unsupported type '%s'", s.Array.DataType())
 		}
 		if int64(s.Array.Len()) != nRows {
@@ -3,10 +3,13 @@ package bow
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/apache/arrow/go/v8/arrow"
 	"github.com/apache/arrow/go/v8/arrow/array"
 	"github.com/apache/arrow/go/v8/arrow/bitutil"
+	"github.com/apache/arrow/go/v8/arrow/decimal128"
 	"github.com/apache/arrow/go/v8/arrow/memory"
 )
 
@@ -30,7 +33,11 @@ func NewSeries(name string, typ Type, dataArray, validityArray interface{}) Seri
 
 // NewSeriesFromBuffer returns a new Series from a name and a Buffer.
 func NewSeriesFromBuffer(name string, buf Buffer) Series {
-	return newSeries(name, buf.DataType, buf.Data, buf.nullBitmapBytes)
+	s := newSeries(name, buf.DataType, buf.Data, buf.nullBitmapBytes)
+	if buf.timeZone != "" && buf.DataType.IsTimestamp() {
+		s = s.withTimeZone(buf.timeZone)
+	}
+	return s
 }
 
 func newSeries(name string, typ Type, dataArray, validityArray interface{}) Series {
@@ -51,6 +58,74 @@ func newSeries(name string, typ Type, dataArray, validityArray interface{}) Seri
 		length := len(dataArray.([]string))
 		nullBitmapBool := buildNullBitmapBool(length, validityArray)
 		return newStringSeries(name, dataArray.([]string), nullBitmapBool)
+	case Dictionary:
+		length := len(dataArray.([]string))
+		nullBitmapBool := buildNullBitmapBool(length, validityArray)
+		return newDictionarySeries(name, dataArray.([]string), nullBitmapBool)
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		length := len(dataArray.([]arrow.Timestamp))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newTimestampSeries(name, typ, length, dataArray.([]arrow.Timestamp), nullBitmapBytes)
+	case Int8:
+		length := len(dataArray.([]int8))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newFixedWidthSeries(name, typ, length, arrow.Int8Traits.CastToBytes(dataArray.([]int8)), nullBitmapBytes)
+	case Int16:
+		length := len(dataArray.([]int16))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newFixedWidthSeries(name, typ, length, arrow.Int16Traits.CastToBytes(dataArray.([]int16)), nullBitmapBytes)
+	case Int32:
+		length := len(dataArray.([]int32))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newFixedWidthSeries(name, typ, length, arrow.Int32Traits.CastToBytes(dataArray.([]int32)), nullBitmapBytes)
+	case Uint8:
+		length := len(dataArray.([]uint8))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newFixedWidthSeries(name, typ, length, arrow.Uint8Traits.CastToBytes(dataArray.([]uint8)), nullBitmapBytes)
+	case Uint16:
+		length := len(dataArray.([]uint16))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newFixedWidthSeries(name, typ, length, arrow.Uint16Traits.CastToBytes(dataArray.([]uint16)), nullBitmapBytes)
+	case Uint32:
+		length := len(dataArray.([]uint32))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newFixedWidthSeries(name, typ, length, arrow.Uint32Traits.CastToBytes(dataArray.([]uint32)), nullBitmapBytes)
+	case Uint64:
+		length := len(dataArray.([]uint64))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newFixedWidthSeries(name, typ, length, arrow.Uint64Traits.CastToBytes(dataArray.([]uint64)), nullBitmapBytes)
+	case Float32:
+		length := len(dataArray.([]float32))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newFixedWidthSeries(name, typ, length, arrow.Float32Traits.CastToBytes(dataArray.([]float32)), nullBitmapBytes)
+	case Decimal:
+		length := len(dataArray.([]Decimal128))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		nums := make([]decimal128.Num, length)
+		for i, v := range dataArray.([]Decimal128) {
+			nums[i] = decimal128.Num(v)
+		}
+		return newFixedWidthSeries(name, typ, length, arrow.Decimal128Traits.CastToBytes(nums), nullBitmapBytes)
+	case List:
+		length := len(dataArray.([][]interface{}))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newListSeries(name, dataArray.([][]interface{}), nullBitmapBytes)
+	case Struct:
+		length := len(dataArray.([]map[string]interface{}))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newStructSeries(name, dataArray.([]map[string]interface{}), nullBitmapBytes)
+	case Binary:
+		length := len(dataArray.([][]byte))
+		nullBitmapBool := buildNullBitmapBool(length, validityArray)
+		return newBinarySeries(name, dataArray.([][]byte), nullBitmapBool)
+	case Date:
+		length := len(dataArray.([]arrow.Date32))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newFixedWidthSeries(name, typ, length, arrow.Date32Traits.CastToBytes(dataArray.([]arrow.Date32)), nullBitmapBytes)
+	case Duration:
+		length := len(dataArray.([]arrow.Duration))
+		nullBitmapBytes := buildNullBitmapBytes(length, validityArray)
+		return newFixedWidthSeries(name, typ, length, arrow.DurationTraits.CastToBytes(dataArray.([]arrow.Duration)), nullBitmapBytes)
 	default:
 		panic(fmt.Errorf("unsupported type '%s'", typ))
 	}
@@ -82,6 +157,30 @@ func newFloat64Series(name string, length int, data []float64, valid []byte) Ser
 	}
 }
 
+func newTimestampSeries(name string, typ Type, length int, data []arrow.Timestamp, valid []byte) Series {
+	return Series{
+		Name: name,
+		Array: array.NewTimestampData(
+			array.NewData(mapBowToArrowTypes[typ], length,
+				[]*memory.Buffer{
+					memory.NewBufferBytes(valid),
+					memory.NewBufferBytes(arrow.TimestampTraits.CastToBytes(data)),
+				}, nil, length-bitutil.CountSetBits(valid, 0, length), 0),
+		),
+	}
+}
+
+// newFixedWidthSeries returns a new Series of a fixed-width Type from its data already cast to bytes.
+func newFixedWidthSeries(name string, typ Type, length int, data, valid []byte) Series {
+	arrData := array.NewData(mapBowToArrowTypes[typ], length,
+		[]*memory.Buffer{
+			memory.NewBufferBytes(valid),
+			memory.NewBufferBytes(data),
+		}, nil, length-bitutil.CountSetBits(valid, 0, length), 0)
+	defer arrData.Release()
+	return Series{Name: name, Array: array.MakeFromData(arrData)}
+}
+
 func newBooleanSeries(name string, data []bool, valid []bool) Series {
 	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
 	builder := array.NewBooleanBuilder(mem)
@@ -98,6 +197,108 @@ func newStringSeries(name string, data []string, valid []bool) Series {
 	return Series{Name: name, Array: builder.NewArray()}
 }
 
+func newBinarySeries(name string, data [][]byte, valid []bool) Series {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	builder := array.NewBinaryBuilder(mem, arrow.BinaryTypes.Binary)
+	defer builder.Release()
+	builder.AppendValues(data, valid)
+	return Series{Name: name, Array: builder.NewArray()}
+}
+
+func newDictionarySeries(name string, data []string, valid []bool) Series {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	builder := array.NewDictionaryBuilder(mem, mapBowToArrowTypes[Dictionary].(*arrow.DictionaryType)).(*array.BinaryDictionaryBuilder)
+	defer builder.Release()
+	builder.Reserve(len(data))
+	for i, v := range data {
+		if valid != nil && !valid[i] {
+			builder.AppendNull()
+			continue
+		}
+		if err := builder.AppendString(v); err != nil {
+			panic(err)
+		}
+	}
+	return Series{Name: name, Array: builder.NewArray()}
+}
+
+// newListSeries returns a new List Series, the Type of the elements being inferred from the non-nil lists.
+func newListSeries(name string, data [][]interface{}, valid []byte) Series {
+	length := len(data)
+	offsets := make([]int32, length+1)
+	var elems []interface{}
+	for i, list := range data {
+		if bitutil.BitIsSet(valid, i) {
+			elems = append(elems, list...)
+		}
+		offsets[i+1] = int32(len(elems))
+	}
+
+	elemType, err := getBowTypeFromInterfaces(elems)
+	if err != nil {
+		panic(err)
+	}
+	elemBuf := NewBuffer(len(elems), elemType)
+	for i, elem := range elems {
+		elemBuf.SetOrDrop(i, elem)
+	}
+	elemArray := NewSeriesFromBuffer("item", elemBuf).Array
+	defer elemArray.Release()
+
+	arrData := array.NewData(arrow.ListOf(elemArray.DataType()), length,
+		[]*memory.Buffer{
+			memory.NewBufferBytes(valid),
+			memory.NewBufferBytes(arrow.Int32Traits.CastToBytes(offsets)),
+		}, []arrow.ArrayData{elemArray.Data()},
+		length-bitutil.CountSetBits(valid, 0, length), 0)
+	defer arrData.Release()
+	return Series{Name: name, Array: array.MakeFromData(arrData)}
+}
+
+// newStructSeries returns a new Struct Series, the fields and their Type being inferred from the non-nil structs.
+// Fields are sorted by name.
+func newStructSeries(name string, data []map[string]interface{}, valid []byte) Series {
+	length := len(data)
+	fieldNameMap := make(map[string]struct{})
+	for i, m := range data {
+		if bitutil.BitIsSet(valid, i) {
+			for fieldName := range m {
+				fieldNameMap[fieldName] = struct{}{}
+			}
+		}
+	}
+	fieldNames := make([]string, 0, len(fieldNameMap))
+	for fieldName := range fieldNameMap {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+
+	fields := make([]arrow.Field, len(fieldNames))
+	fieldsData := make([]arrow.ArrayData, len(fieldNames))
+	for f, fieldName := range fieldNames {
+		values := make([]interface{}, length)
+		for i, m := range data {
+			if bitutil.BitIsSet(valid, i) {
+				values[i] = m[fieldName]
+			}
+		}
+		fieldType, err := getBowTypeFromInterfaces(values)
+		if err != nil {
+			panic(err)
+		}
+		fieldArray := NewSeriesFromInterfaces(fieldName, fieldType, values).Array
+		defer fieldArray.Release()
+		fields[f] = arrow.Field{Name: fieldName, Type: fieldArray.DataType(), Nullable: true}
+		fieldsData[f] = fieldArray.Data()
+	}
+
+	arrData := array.NewData(arrow.StructOf(fields...), length,
+		[]*memory.Buffer{memory.NewBufferBytes(valid)}, fieldsData,
+		length-bitutil.CountSetBits(valid, 0, length), 0)
+	defer arrData.Release()
+	return Series{Name: name, Array: array.MakeFromData(arrData)}
+}
+
 // NewSeriesFromInterfaces returns a new Series from:
 // - name: string
 // - typ: Bow Type
@@ -164,9 +365,59 @@ func NewSeriesFromInterfaces(name string, typ Type, data []interface{}) Series {
 			builder.Append(v)
 		}
 		return Series{Name: name, Array: builder.NewArray()}
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		builder := array.NewTimestampBuilder(mem, mapBowToArrowTypes[typ].(*arrow.TimestampType))
+		defer builder.Release()
+		builder.Resize(len(data))
+		for i := 0; i < len(data); i++ {
+			v, ok := ToTimestamp(data[i], typ.TimeUnit())
+			if !ok {
+				builder.AppendNull()
+				continue
+			}
+			builder.Append(v)
+		}
+		return Series{Name: name, Array: builder.NewArray()}
 	default:
-		panic(fmt.Errorf("unsupported type '%s'", typ))
+		if !typ.IsSupported() {
+			panic(fmt.Errorf("unsupported type '%s'", typ))
+		}
+		buf := NewBuffer(len(data), typ)
+		for i := 0; i < len(data); i++ {
+			buf.SetOrDrop(i, data[i])
+		}
+		return NewSeriesFromBuffer(name, buf)
+	}
+}
+
+// WithTimeZone returns a copy of the timestamp Series with the time zone `timeZone`, sharing the same data.
+// An empty `timeZone` removes the time zone from the Series.
+// The time zone is kept by the operations returning zero-copy columns, such as NewSlice, Select or AddCols,
+// by the operations rebuilding columns from their Buffers, such as SortByCol, SortBy, Filter, FilterWhere, Distinct,
+// the joins and the fills, by the JSON encodings, and by AppendBows, which keeps the time zone of the first Bow.
+// Other operations, such as the rolling aggregations, return timestamp Series without time zone.
+func (s Series) WithTimeZone(timeZone string) (Series, error) {
+	if _, ok := s.Array.DataType().(*arrow.TimestampType); !ok {
+		return Series{}, fmt.Errorf("series '%s' of type '%s' is not a timestamp", s.Name, s.Array.DataType())
 	}
+
+	if _, err := time.LoadLocation(timeZone); err != nil {
+		return Series{}, fmt.Errorf("time.LoadLocation: %w", err)
+	}
+
+	return s.withTimeZone(timeZone), nil
+}
+
+// withTimeZone returns a copy of the timestamp Series with the time zone `timeZone`, without validating it.
+func (s Series) withTimeZone(timeZone string) Series {
+	timestampType := s.Array.DataType().(*arrow.TimestampType)
+	data := s.Array.Data()
+	newData := array.NewData(
+		&arrow.TimestampType{Unit: timestampType.Unit, TimeZone: timeZone},
+		data.Len(), data.Buffers(), nil, data.NullN(), data.Offset())
+	defer newData.Release()
+
+	return Series{Name: s.Name, Array: array.MakeFromData(newData)}
 }
 
 func getBowTypeFromInterfaces(colBasedData []interface{}) (Type, error) {
@@ -177,10 +428,47 @@ func getBowTypeFromInterfaces(colBasedData []interface{}) (Type, error) {
 				return Float64, nil
 			case int, int64:
 				return Int64, nil
+			case int8:
+				return Int8, nil
+			case int16:
+				return Int16, nil
+			case int32:
+				return Int32, nil
+			case uint8:
+				return Uint8, nil
+			case uint16:
+				return Uint16, nil
+			case uint32:
+				return Uint32, nil
+			case uint64:
+				return Uint64, nil
+			case float32:
+				return Float32, nil
+			case Decimal128:
+				return Decimal, nil
+			case []byte:
+				return Binary, nil
+			case []interface{}:
+				return List, nil
+			case map[string]interface{}:
+				return Struct, nil
 			case string:
 				return String, nil
 			case bool:
 				return Boolean, nil
+			case time.Time:
+				return TimestampNano, nil
+			case arrow.Date32:
+				return Date, nil
+			case arrow.Duration, time.Duration:
+				return Duration, nil
+			default:
+				if _, ok := ToList(val); ok {
+					return List, nil
+				}
+				if _, ok := ToStruct(val); ok {
+					return Struct, nil
+				}
 			}
 		}
 	}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/apache/arrow/go/v8/arrow"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -20,6 +22,73 @@ func TestNewSeriesFromInterfaces(t *testing.T) {
 	}
 }
 
+func TestSeries_WithTimeZone(t *testing.T) {
+	s := NewSeries("time", TimestampSec, []arrow.Timestamp{1, 2}, []bool{true, false})
+
+	t.Run("valid time zone", func(t *testing.T) {
+		res, err := s.WithTimeZone("Europe/Paris")
+		require.NoError(t, err)
+		assert.Equal(t, &arrow.TimestampType{Unit: arrow.Second, TimeZone: "Europe/Paris"}, res.Array.DataType())
+
+		b, err := NewBow(res)
+		require.NoError(t, err)
+		assert.Equal(t, TimestampSec, b.ColumnType(0))
+		assert.Equal(t, arrow.Timestamp(1), b.GetValue(0, 0))
+		assert.Nil(t, b.GetValue(0, 1))
+	})
+
+	t.Run("time zone kept by operations", func(t *testing.T) {
+		timeSeries, err := NewSeries("time", TimestampSec, []arrow.Timestamp{2, 1, 3}, []bool{true, true, false}).
+			WithTimeZone("Europe/Paris")
+		require.NoError(t, err)
+		b, err := NewBow(timeSeries, NewSeries("value", Int64, []int64{2, 1, 3}, nil))
+		require.NoError(t, err)
+		other, err := NewBow(NewSeries("value", Int64, []int64{1, 2}, nil))
+		require.NoError(t, err)
+
+		operations := map[string]func() (Bow, error){
+			"SortByCol":    func() (Bow, error) { return b.SortByCol(1) },
+			"SortBy":       func() (Bow, error) { return b.SortBy(SortKey{ColIndex: 1, Descending: true}) },
+			"Filter":       func() (Bow, error) { return b.Filter(b.MakeFilterValues(1, int64(1), int64(3))), nil },
+			"FilterWhere":  func() (Bow, error) { return b.FilterWhere(ColGt(1, 1)) },
+			"InnerJoin":    func() (Bow, error) { return b.InnerJoin(other), nil },
+			"OuterJoin":    func() (Bow, error) { return b.OuterJoin(other), nil },
+			"Join":         func() (Bow, error) { return b.Join(other, JoinOptions{LeftOn: []string{"value"}, Kind: JoinLeft}) },
+			"FillPrevious": func() (Bow, error) { return b.FillPrevious(0) },
+			"FillNext":     func() (Bow, error) { return b.FillNext(0) },
+			"AppendBows":   func() (Bow, error) { return AppendBows(b, b) },
+			"Distinct":     func() (Bow, error) { return b.Distinct(0), nil },
+			"JSON": func() (Bow, error) {
+				data, err := b.MarshalJSONWithOptions(JSONOptions{})
+				require.NoError(t, err)
+				res := NewBowEmpty()
+				return res, res.UnmarshalJSON(data)
+			},
+			"column-based JSON": func() (Bow, error) {
+				data, err := b.MarshalJSONWithOptions(JSONOptions{ColBased: true})
+				require.NoError(t, err)
+				res := NewBowEmpty()
+				return res, res.UnmarshalJSON(data)
+			},
+		}
+		for name, operation := range operations {
+			res, err := operation()
+			require.NoError(t, err, name)
+			assert.Equal(t, timeSeries.Array.DataType(), res.Schema().Field(0).Type, name)
+		}
+	})
+
+	t.Run("invalid time zone", func(t *testing.T) {
+		_, err := s.WithTimeZone("Not/AZone")
+		assert.Error(t, err)
+	})
+
+	t.Run("not a timestamp", func(t *testing.T) {
+		_, err := NewSeries("value", Int64, []int64{1}, nil).WithTimeZone("UTC")
+		assert.Error(t, err)
+	})
+}
+
 func BenchmarkNewSeries(b *testing.B) {
 	for rows := 10; rows <= 100000; rows *= 10 {
 		dataArray := make([]int64, rows)
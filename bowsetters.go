@@ -2,6 +2,10 @@ package bow
 
 import (
 	"fmt"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
 )
 
 // RenameCol returns a new Bow with the column `colIndex` renamed.
@@ -71,6 +75,11 @@ func (b *bow) Filter(fns ...RowCmp) Bow {
 		}
 	}
 
+	return b.newBowFromRowIndices(indices)
+}
+
+// newBowFromRowIndices returns a new Bow with the rows at `indices`, in ascending order, gathering each column once.
+func (b *bow) newBowFromRowIndices(indices []int) Bow {
 	if len(indices) == 0 {
 		return b.NewEmptySlice()
 	}
@@ -83,10 +92,11 @@ func (b *bow) Filter(fns ...RowCmp) Bow {
 
 	filteredSeries := make([]Series, b.NumCols())
 	for colIndex := 0; colIndex < b.NumCols(); colIndex++ {
-		buf := NewBuffer(len(indices), b.ColumnType(colIndex))
-		for i, j := range indices {
-			buf.SetOrDropStrict(i, b.GetValue(colIndex, j))
+		if b.ColumnType(colIndex) == Dictionary {
+			filteredSeries[colIndex] = b.takeDictionaryCodes(colIndex, indices)
+			continue
 		}
+		buf := b.NewBufferFromCol(colIndex).newBufferFromIndices(indices)
 		filteredSeries[colIndex] = NewSeriesFromBuffer(b.ColumnName(colIndex), buf)
 	}
 
@@ -98,6 +108,53 @@ func (b *bow) Filter(fns ...RowCmp) Bow {
 	return res
 }
 
+// takeDictionaryCodes gathers the codes of a Dictionary column at `indices`, sharing its dictionary.
+func (b *bow) takeDictionaryCodes(colIndex int, indices []int) Series {
+	arr := b.Column(colIndex).(*array.Dictionary)
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	builder := array.NewBuilder(mem, arr.DataType().(*arrow.DictionaryType).IndexType)
+	defer builder.Release()
+	builder.Reserve(len(indices))
+	for _, j := range indices {
+		if arr.IsValid(j) {
+			appendDictionaryCode(builder, arr.GetValueIndex(j))
+		} else {
+			builder.AppendNull()
+		}
+	}
+	codes := builder.NewArray()
+	defer codes.Release()
+
+	return Series{
+		Name:  b.ColumnName(colIndex),
+		Array: array.NewDictionaryArray(arr.DataType(), codes, arr.Dictionary()),
+	}
+}
+
+// appendDictionaryCode appends `code` to a builder of any of the integer index types of a dictionary.
+func appendDictionaryCode(builder array.Builder, code int) {
+	switch builder := builder.(type) {
+	case *array.Int8Builder:
+		builder.Append(int8(code))
+	case *array.Int16Builder:
+		builder.Append(int16(code))
+	case *array.Int32Builder:
+		builder.Append(int32(code))
+	case *array.Int64Builder:
+		builder.Append(int64(code))
+	case *array.Uint8Builder:
+		builder.Append(uint8(code))
+	case *array.Uint16Builder:
+		builder.Append(uint16(code))
+	case *array.Uint32Builder:
+		builder.Append(uint32(code))
+	case *array.Uint64Builder:
+		builder.Append(uint64(code))
+	default:
+		panic(fmt.Errorf("unsupported dictionary index builder %T", builder))
+	}
+}
+
 func matchRowCmps(b Bow, i int, fns ...RowCmp) bool {
 	for _, fn := range fns {
 		if !fn(b, i) {
@@ -111,7 +168,12 @@ func matchRowCmps(b Bow, i int, fns ...RowCmp) bool {
 // MakeFilterValues prepares a valid comparator for Filter, it is lazy on given type.
 // Be careful about number to string though, for instance 0.1 give "0.100000", which could be unexpected
 // If value is of the wrong type and not convertible to column type, comparison will be done on null values!
+// On Dictionary columns, values are matched once against the dictionary, then rows are compared on codes.
 func (b *bow) MakeFilterValues(colIndex int, values ...interface{}) RowCmp {
+	if b.ColumnType(colIndex) == Dictionary {
+		return b.makeFilterDictionaryCodes(colIndex, values...)
+	}
+
 	for i := range values {
 		values[i] = b.ColumnType(colIndex).Convert(values[i])
 	}
@@ -121,9 +183,29 @@ func (b *bow) MakeFilterValues(colIndex int, values ...interface{}) RowCmp {
 	}
 }
 
+func (b *bow) makeFilterDictionaryCodes(colIndex int, values ...interface{}) RowCmp {
+	arr := b.Column(colIndex).(*array.Dictionary)
+	codes := dictionaryCodes(arr, values...)
+	for i := range values {
+		values[i] = Dictionary.Convert(values[i])
+	}
+
+	return func(b Bow, i int) bool {
+		other, ok := (*b.ArrowRecord()).Column(colIndex).(*array.Dictionary)
+		if !ok || other.Dictionary() != arr.Dictionary() {
+			return contains(values, b.GetValue(colIndex, i))
+		}
+		if !other.IsValid(i) {
+			return contains(values, nil)
+		}
+		_, ok = codes[other.GetValueIndex(i)]
+		return ok
+	}
+}
+
 func contains(values []interface{}, value interface{}) bool {
 	for _, val := range values {
-		if val == value {
+		if isEqual(val, value) {
 			return true
 		}
 	}
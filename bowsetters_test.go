@@ -3,6 +3,10 @@ package bow
 import (
 	"testing"
 
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+	"github.com/apache/arrow/go/v8/arrow/memory"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -53,6 +57,31 @@ func TestBow_Apply(t *testing.T) {
 	ExpectEqual(t, expect, res)
 }
 
+func TestBow_Convert(t *testing.T) {
+	t.Run("to decimal", func(t *testing.T) {
+		b, err := NewBow(
+			NewSeries("int", Int64, []int64{1, 2}, nil),
+			NewSeries("string", String, []string{"0.123456789", "not a number"}, nil),
+		)
+		require.NoError(t, err)
+
+		res, err := b.Convert(0, Decimal)
+		require.NoError(t, err)
+		res, err = res.Convert(1, Decimal)
+		require.NoError(t, err)
+
+		expect, err := NewBowFromColBasedInterfaces(
+			[]string{"int", "string"},
+			[]Type{Decimal, Decimal},
+			[][]interface{}{
+				{"1", "2"},
+				{"0.123456789", nil},
+			})
+		require.NoError(t, err)
+		ExpectEqual(t, expect, res)
+	})
+}
+
 func TestBow_Filter(t *testing.T) {
 	b, err := NewBowWithMetadata(NewMetadata([]string{"k"}, []string{"v"}),
 		NewSeries("string", String, []string{"0.1", "0.2"}, nil),
@@ -132,4 +161,57 @@ func TestBow_Filter(t *testing.T) {
 		ExpectEqual(t, expect, res)
 	})
 
+	t.Run("dictionary codes", func(t *testing.T) {
+		b, err := NewBow(
+			NewSeries("dict", Dictionary, []string{"a", "b", "a", "c", "b"},
+				[]bool{true, true, true, true, false}),
+			NewSeries("int", Int64, []int64{1, 2, 3, 4, 5}, nil),
+		)
+		require.NoError(t, err)
+		expect, err := NewBow(
+			NewSeries("dict", Dictionary, []string{"a", "a", "c"}, nil),
+			NewSeries("int", Int64, []int64{1, 3, 4}, nil),
+		)
+		require.NoError(t, err)
+
+		res := b.Filter(b.MakeFilterValues(0, "a", "c", "not found"))
+		ExpectEqual(t, expect, res)
+		assert.Equal(t, Dictionary, res.ColumnType(0))
+	})
+
+	t.Run("dictionary codes with int8 indices", func(t *testing.T) {
+		b, err := NewBow(newInt8DictionarySeries("dict", []int8{0, 1, 2, 1, 1}, []string{"a", "b", "c"}))
+		require.NoError(t, err)
+		expect, err := NewBow(newInt8DictionarySeries("dict", []int8{1, 1, 1}, []string{"a", "b", "c"}))
+		require.NoError(t, err)
+
+		res := b.Filter(b.MakeFilterValues(0, "b"))
+		ExpectEqual(t, expect, res)
+
+		res, err = b.FilterWhere(ColEq(0, "b"))
+		require.NoError(t, err)
+		ExpectEqual(t, expect, res)
+	})
+}
+
+func newInt8DictionarySeries(name string, codes []int8, values []string) Series {
+	mem := memory.NewCheckedAllocator(memory.NewGoAllocator())
+	codesBuilder := array.NewInt8Builder(mem)
+	defer codesBuilder.Release()
+	codesBuilder.AppendValues(codes, nil)
+	codesArr := codesBuilder.NewArray()
+	defer codesArr.Release()
+	valuesBuilder := array.NewStringBuilder(mem)
+	defer valuesBuilder.Release()
+	valuesBuilder.AppendValues(values, nil)
+	valuesArr := valuesBuilder.NewArray()
+	defer valuesArr.Release()
+
+	return Series{
+		Name: name,
+		Array: array.NewDictionaryArray(&arrow.DictionaryType{
+			IndexType: arrow.PrimitiveTypes.Int8,
+			ValueType: arrow.BinaryTypes.String,
+		}, codesArr, valuesArr),
+	}
 }
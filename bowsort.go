@@ -5,56 +5,118 @@ import (
 	"sort"
 )
 
+// SortKey is a column to sort the rows of a Bow by, with:
+// - ColIndex: index of the column
+// - Descending: sorts the values in descending order instead of ascending order
+// - NullsFirst: places the nil values before the other ones instead of after
+type SortKey struct {
+	ColIndex   int
+	Descending bool
+	NullsFirst bool
+}
+
 // SortByCol returns a new Bow with the rows sorted by a column in ascending order.
 // Returns the same Bow if the column is already sorted.
 func (b *bow) SortByCol(colIndex int) (Bow, error) {
+	if colIndex >= b.NumCols() {
+		return nil, fmt.Errorf("column index out of bound")
+	}
+
 	if b.Column(colIndex).NullN() != 0 {
 		return nil, fmt.Errorf(
 			"column to sort by has %d nil values",
 			b.Column(colIndex).NullN())
 	}
 
-	sortableBuf := newBufferWithIndices(b.NewBufferFromCol(colIndex))
 	// Stop if sort by column is already sorted
-	if sortableBuf.IsSorted() {
+	if b.NewBufferFromCol(colIndex).IsSorted() {
 		return b, nil
 	}
 
-	// Sort the column by ascending values
-	sort.Sort(sortableBuf)
+	return b.SortBy(SortKey{ColIndex: colIndex})
+}
+
+// SortBy returns a new Bow with the rows sorted by the columns of the `keys`, in their order of priority.
+// The sort is stable: rows with equal values for all the keys keep their relative order.
+// NaN values are sorted after the other values in ascending order, and before them in descending order.
+func (b *bow) SortBy(keys ...SortKey) (Bow, error) {
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no sort key")
+	}
+
+	bufs := make([]Buffer, len(keys))
+	for i, key := range keys {
+		if key.ColIndex < 0 || key.ColIndex >= b.NumCols() {
+			return nil, fmt.Errorf("sort key %d: column index %d out of bound", i, key.ColIndex)
+		}
+		bufs[i] = b.NewBufferFromCol(key.ColIndex)
+	}
 
-	// Fill the sort by column with sorted values
+	// The rows are sorted through a permutation of their indices
+	indices := make([]int, b.NumRows())
+	for i := range indices {
+		indices[i] = i
+	}
+	sort.SliceStable(indices, func(i, j int) bool {
+		for keyIndex, key := range keys {
+			if cmp := compareSortKeyRows(bufs[keyIndex], key, indices[i], indices[j]); cmp != 0 {
+				return cmp < 0
+			}
+		}
+		return false
+	})
+
+	// Dictionary columns keep their codes and dictionary, and the key columns reuse their Buffers
+	keyBufs := make(map[int]Buffer, len(keys))
+	for i, key := range keys {
+		keyBufs[key.ColIndex] = bufs[i]
+	}
 	sortedSeries := make([]Series, b.NumCols())
-	for i := 0; i < b.NumCols(); i++ {
-		if i == colIndex {
-			sortedSeries[i] = NewSeriesFromBuffer(b.ColumnName(i), sortableBuf.Buffer)
+	for colIndex := 0; colIndex < b.NumCols(); colIndex++ {
+		if b.ColumnType(colIndex) == Dictionary {
+			sortedSeries[colIndex] = b.takeDictionaryCodes(colIndex, indices)
 			continue
 		}
-		buf := NewBuffer(b.NumRows(), b.ColumnType(i))
-		for j, index := range sortableBuf.indices {
-			buf.SetOrDropStrict(j, b.GetValue(i, index))
+		buf, ok := keyBufs[colIndex]
+		if !ok {
+			buf = b.NewBufferFromCol(colIndex)
 		}
-		sortedSeries[i] = NewSeriesFromBuffer(b.ColumnName(i), buf)
+		sortedSeries[colIndex] = NewSeriesFromBuffer(b.ColumnName(colIndex), buf.newBufferFromIndices(indices))
 	}
 
 	return NewBowWithMetadata(b.Metadata(), sortedSeries...)
 }
 
-// bufferWithIndices implements the methods of sort.Interface, sorting in ascending order.
-type bufferWithIndices struct {
-	Buffer
-	indices []int
-}
-
-func newBufferWithIndices(buf Buffer) bufferWithIndices {
-	indices := make([]int, buf.Len())
-	for i := 0; i < buf.Len(); i++ {
-		indices[i] = i
+// compareSortKeyRows returns a negative number if the row `i` of `buf` is sorted before the row `j` according to `key`,
+// a positive number if it is sorted after, and 0 if both rows are equal.
+func compareSortKeyRows(buf Buffer, key SortKey, i, j int) int {
+	iIsNull, jIsNull := buf.IsNull(i), buf.IsNull(j)
+	switch {
+	case iIsNull && jIsNull:
+		return 0
+	case iIsNull != jIsNull:
+		if iIsNull == key.NullsFirst {
+			return -1
+		}
+		return 1
 	}
-	return bufferWithIndices{Buffer: buf, indices: indices}
-}
 
-func (p bufferWithIndices) Swap(i, j int) {
-	p.Buffer.Swap(i, j)
-	p.indices[i], p.indices[j] = p.indices[j], p.indices[i]
+	// NaN values are greater than all the other values, so that the order is total
+	var cmp int
+	iIsNaN, jIsNaN := buf.isNaN(i), buf.isNaN(j)
+	if iIsNaN || jIsNaN {
+		if !jIsNaN {
+			cmp = 1
+		} else if !iIsNaN {
+			cmp = -1
+		}
+	} else if buf.Less(i, j) {
+		cmp = -1
+	} else if buf.Less(j, i) {
+		cmp = 1
+	}
+	if key.Descending {
+		return -cmp
+	}
+	return cmp
 }
@@ -2,8 +2,10 @@ package bow
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
+	"github.com/apache/arrow/go/v8/arrow/array"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -203,6 +205,169 @@ func TestBow_SortByCol(t *testing.T) {
 	})
 }
 
+func TestBow_SortBy(t *testing.T) {
+	b, err := NewBowFromRowBasedInterfaces(
+		[]string{"site", "value", "time", "label"},
+		[]Type{String, Float64, Int64, Dictionary},
+		[][]interface{}{
+			{"b", 2., 1, "r0"},
+			{"a", nil, 2, "r1"},
+			{"b", 1., 3, "r2"},
+			{nil, 3., 4, "r3"},
+			{"a", 5., 5, "r4"},
+			{"b", 2., 6, "r5"},
+		})
+	require.NoError(t, err)
+	b = b.SetMetadata("k", "v")
+
+	t.Run("ascending with nulls last", func(t *testing.T) {
+		res, err := b.SortBy(SortKey{ColIndex: 0}, SortKey{ColIndex: 1})
+		require.NoError(t, err)
+
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"site", "value", "time", "label"},
+			[]Type{String, Float64, Int64, Dictionary},
+			[][]interface{}{
+				{"a", 5., 5, "r4"},
+				{"a", nil, 2, "r1"},
+				{"b", 1., 3, "r2"},
+				{"b", 2., 1, "r0"},
+				{"b", 2., 6, "r5"},
+				{nil, 3., 4, "r3"},
+			})
+		require.NoError(t, err)
+		expected = expected.SetMetadata("k", "v")
+		ExpectEqual(t, expected, res)
+	})
+
+	t.Run("descending with nulls first", func(t *testing.T) {
+		res, err := b.SortBy(
+			SortKey{ColIndex: 0, Descending: true, NullsFirst: true},
+			SortKey{ColIndex: 1, Descending: true, NullsFirst: true})
+		require.NoError(t, err)
+
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"site", "value", "time", "label"},
+			[]Type{String, Float64, Int64, Dictionary},
+			[][]interface{}{
+				{nil, 3., 4, "r3"},
+				{"b", 2., 1, "r0"},
+				{"b", 2., 6, "r5"},
+				{"b", 1., 3, "r2"},
+				{"a", nil, 2, "r1"},
+				{"a", 5., 5, "r4"},
+			})
+		require.NoError(t, err)
+		expected = expected.SetMetadata("k", "v")
+		ExpectEqual(t, expected, res)
+	})
+
+	t.Run("other types", func(t *testing.T) {
+		b, err := NewBowFromRowBasedInterfaces(
+			[]string{"date", "decimal", "binary"},
+			[]Type{Date, Decimal, Binary},
+			[][]interface{}{
+				{"2024-01-02", "1.5", []byte("b")},
+				{nil, nil, nil},
+				{"2024-01-01", "2.5", []byte("a")},
+			})
+		require.NoError(t, err)
+
+		res, err := b.SortBy(SortKey{ColIndex: 0})
+		require.NoError(t, err)
+
+		expected, err := NewBowFromRowBasedInterfaces(
+			[]string{"date", "decimal", "binary"},
+			[]Type{Date, Decimal, Binary},
+			[][]interface{}{
+				{"2024-01-01", "2.5", []byte("a")},
+				{"2024-01-02", "1.5", []byte("b")},
+				{nil, nil, nil},
+			})
+		require.NoError(t, err)
+		ExpectEqual(t, expected, res)
+	})
+
+	t.Run("dictionary codes with int8 indices", func(t *testing.T) {
+		b, err := NewBow(
+			newInt8DictionarySeries("label", []int8{2, 0, 1, 0}, []string{"b", "c", "a"}),
+			NewSeries("row", Int64, []int64{0, 1, 2, 3}, nil),
+		)
+		require.NoError(t, err)
+
+		res, err := b.SortBy(SortKey{ColIndex: 0})
+		require.NoError(t, err)
+
+		expected, err := NewBow(
+			newInt8DictionarySeries("label", []int8{2, 0, 0, 1}, []string{"b", "c", "a"}),
+			NewSeries("row", Int64, []int64{0, 1, 3, 2}, nil),
+		)
+		require.NoError(t, err)
+		ExpectEqual(t, expected, res)
+		assert.Equal(t, expected.Schema(), res.Schema())
+		dictionary := b.(*bow).Column(0).(*array.Dictionary).Dictionary()
+		assert.Same(t, dictionary.Data(), res.(*bow).Column(0).(*array.Dictionary).Dictionary().Data())
+	})
+
+	t.Run("NaN values", func(t *testing.T) {
+		nan := math.NaN()
+		b, err := NewBow(
+			NewSeries("f64", Float64, []float64{1, nan, 2, 1, nan, 2, 1, 0}, []bool{true, true, true, true, true, true, true, false}),
+			NewSeries("f32", Float32, []float32{1, float32(nan), 2, 1, float32(nan), 2, 1, 0}, []bool{true, true, true, true, true, true, true, false}),
+			NewSeries("row", Int64, []int64{0, 1, 2, 3, 4, 5, 6, 7}, nil),
+		)
+		require.NoError(t, err)
+
+		expectRows := func(keys SortKey, rows []int64) {
+			res, err := b.SortBy(keys)
+			require.NoError(t, err)
+			have, err := res.Select(2)
+			require.NoError(t, err)
+			expected, err := NewBow(NewSeries("row", Int64, rows, nil))
+			require.NoError(t, err)
+			ExpectEqual(t, expected, have)
+		}
+		for colIndex := 0; colIndex < 2; colIndex++ {
+			expectRows(SortKey{ColIndex: colIndex}, []int64{0, 3, 6, 2, 5, 1, 4, 7})
+			expectRows(SortKey{ColIndex: colIndex, Descending: true}, []int64{1, 4, 2, 5, 0, 3, 6, 7})
+			expectRows(SortKey{ColIndex: colIndex, NullsFirst: true}, []int64{7, 0, 3, 6, 2, 5, 1, 4})
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		empty, err := b.Select()
+		require.NoError(t, err)
+		_, err = empty.SortBy(SortKey{ColIndex: 0})
+		assert.Error(t, err)
+
+		res, err := b.NewEmptySlice().SortBy(SortKey{ColIndex: 0})
+		require.NoError(t, err)
+		assert.Equal(t, 0, res.NumRows())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := b.SortBy()
+		assert.Error(t, err)
+		_, err = b.SortBy(SortKey{ColIndex: 4})
+		assert.Error(t, err)
+	})
+}
+
+func BenchmarkBow_SortBy(b *testing.B) {
+	for rows := 10; rows <= 100000; rows *= 10 {
+		data, err := NewBowFromParquet(fmt.Sprintf(
+			"%sbow1-%d-rows.parquet", benchmarkBowsDirPath, rows), false)
+		require.NoError(b, err)
+
+		b.Run(fmt.Sprintf("%d_rows", rows), func(b *testing.B) {
+			for n := 0; n < b.N; n++ {
+				_, err = data.SortBy(SortKey{ColIndex: 1, Descending: true}, SortKey{ColIndex: 0})
+				require.NoError(b, err)
+			}
+		})
+	}
+}
+
 func BenchmarkBow_SortByCol(b *testing.B) {
 	for rows := 10; rows <= 100000; rows *= 10 {
 		data, err := NewBowFromParquet(fmt.Sprintf(
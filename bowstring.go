@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 	"text/tabwriter"
+
+	"github.com/apache/arrow/go/v8/arrow"
 )
 
 // String returns a formatted representation of the Bow.
@@ -31,7 +33,7 @@ func (b *bow) String() string {
 	for row := range b.GetRowsChan() {
 		cells = []string{}
 		for colIndex := 0; colIndex < b.NumCols(); colIndex++ {
-			cells = append(cells, fmt.Sprintf("%v", row[b.Schema().Field(colIndex).Name]))
+			cells = append(cells, formatValue(row[b.Schema().Field(colIndex).Name]))
 		}
 		if _, err = fmt.Fprintln(w, strings.Join(cells, "\t")); err != nil {
 			panic(err)
@@ -50,3 +52,14 @@ func (b *bow) String() string {
 
 	return writer.String()
 }
+
+// formatValue returns the representation of a value in a formatted Bow.
+// Date and Duration values are represented in a human-readable form instead of their underlying integers.
+func formatValue(value interface{}) string {
+	switch value.(type) {
+	case arrow.Date32, arrow.Duration:
+		str, _ := ToString(value)
+		return str
+	}
+	return fmt.Sprintf("%v", value)
+}
@@ -0,0 +1,141 @@
+package bow
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/apache/arrow/go/v8/arrow"
+)
+
+// SubTimestamps returns a new Bow with an additional Duration column `newColName`,
+// holding the elapsed time from the column `rightColIndex` to the column `leftColIndex`.
+// Both columns need to be of a timestamp or Date Type, possibly with different time units.
+// If any of the two values is nil, the result is nil.
+func (b *bow) SubTimestamps(leftColIndex, rightColIndex int, newColName string) (Bow, error) {
+	for _, colIndex := range []int{leftColIndex, rightColIndex} {
+		if err := b.checkTemporalCol(colIndex); err != nil {
+			return nil, err
+		}
+	}
+
+	buf := NewBuffer(b.NumRows(), Duration)
+	for rowIndex := 0; rowIndex < b.NumRows(); rowIndex++ {
+		left, leftOk := b.getTime(leftColIndex, rowIndex)
+		right, rightOk := b.getTime(rightColIndex, rowIndex)
+		if leftOk && rightOk {
+			buf.SetOrDropStrict(rowIndex, arrow.Duration(left.Sub(right)))
+		}
+	}
+
+	return b.AddCols(NewSeriesFromBuffer(newColName, buf))
+}
+
+// AddDuration returns a new Bow with the timestamp or Date column `colIndex`
+// shifted by the values of the Duration column `durationColIndex`.
+// Dates are truncated to the day of the shifted value.
+// If any of the two values is nil, the result is nil.
+func (b *bow) AddDuration(colIndex, durationColIndex int) (Bow, error) {
+	if err := b.checkTemporalCol(colIndex); err != nil {
+		return nil, err
+	}
+
+	if durationColIndex < 0 || durationColIndex >= b.NumCols() {
+		return nil, fmt.Errorf("column index out of bound")
+	}
+
+	if b.ColumnType(durationColIndex) != Duration {
+		return nil, fmt.Errorf("column '%s' of type '%s' is not a duration",
+			b.ColumnName(durationColIndex), b.ColumnType(durationColIndex))
+	}
+
+	return b.shiftTemporalCol(colIndex, func(t time.Time, rowIndex int) (time.Time, bool) {
+		d, ok := b.GetValue(durationColIndex, rowIndex).(arrow.Duration)
+		return t.Add(time.Duration(d)), ok
+	})
+}
+
+// AddDate returns a new Bow with the timestamp or Date column `colIndex`
+// shifted by the given number of years, months and days, following the calendar rules of time.Time.AddDate.
+// Timestamps are shifted in the time zone of the column if any, in UTC otherwise.
+func (b *bow) AddDate(colIndex int, years, months, days int) (Bow, error) {
+	if err := b.checkTemporalCol(colIndex); err != nil {
+		return nil, err
+	}
+
+	return b.shiftTemporalCol(colIndex, func(t time.Time, rowIndex int) (time.Time, bool) {
+		return t.AddDate(years, months, days), true
+	})
+}
+
+func (b *bow) checkTemporalCol(colIndex int) error {
+	if colIndex < 0 || colIndex >= b.NumCols() {
+		return fmt.Errorf("column index out of bound")
+	}
+
+	if typ := b.ColumnType(colIndex); !typ.IsTimestamp() && typ != Date {
+		return fmt.Errorf("column '%s' of type '%s' is not a timestamp or a date",
+			b.ColumnName(colIndex), typ)
+	}
+
+	return nil
+}
+
+// shiftTemporalCol returns a new Bow with the values of the column `colIndex` replaced by the results of `fn`,
+// the time zone of timestamp columns being kept.
+func (b *bow) shiftTemporalCol(colIndex int, fn func(t time.Time, rowIndex int) (time.Time, bool)) (Bow, error) {
+	typ := b.ColumnType(colIndex)
+	buf := NewBuffer(b.NumRows(), typ)
+	for rowIndex := 0; rowIndex < b.NumRows(); rowIndex++ {
+		t, ok := b.getTime(colIndex, rowIndex)
+		if !ok {
+			continue
+		}
+		if t, ok = fn(t, rowIndex); !ok {
+			continue
+		}
+		if typ == Date {
+			buf.SetOrDropStrict(rowIndex, timeToDate(t))
+		} else {
+			buf.SetOrDropStrict(rowIndex, timeToTimestamp(t, typ.TimeUnit()))
+		}
+	}
+
+	shifted := NewSeriesFromBuffer(b.ColumnName(colIndex), buf)
+	if timestampType, ok := b.Schema().Field(colIndex).Type.(*arrow.TimestampType); ok {
+		var err error
+		if shifted, err = shifted.WithTimeZone(timestampType.TimeZone); err != nil {
+			return nil, fmt.Errorf("Series.WithTimeZone: %w", err)
+		}
+	}
+
+	series := make([]Series, b.NumCols())
+	for i := range b.Columns() {
+		if i == colIndex {
+			series[i] = shifted
+		} else {
+			series[i] = b.NewSeriesFromCol(i)
+		}
+	}
+
+	return NewBowWithMetadata(b.Metadata(), series...)
+}
+
+// getTime returns the value of a timestamp or Date column as a time.Time,
+// in the time zone of the column if any, in UTC otherwise.
+// Returns false if the value is nil.
+func (b *bow) getTime(colIndex, rowIndex int) (time.Time, bool) {
+	switch v := b.GetValue(colIndex, rowIndex).(type) {
+	case arrow.Date32:
+		return v.ToTime(), true
+	case arrow.Timestamp:
+		timestampType := b.Schema().Field(colIndex).Type.(*arrow.TimestampType)
+		t := timestampToTime(v, timestampType.Unit)
+		if timestampType.TimeZone != "" {
+			if loc, err := time.LoadLocation(timestampType.TimeZone); err == nil {
+				t = t.In(loc)
+			}
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
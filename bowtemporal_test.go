@@ -0,0 +1,85 @@
+package bow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTemporalArithmetic(t *testing.T) {
+	b, err := NewBowFromColBasedInterfaces(
+		[]string{"start", "end", "day", "outage"},
+		[]Type{TimestampSec, TimestampMilli, Date, Duration},
+		[][]interface{}{
+			{"2023-01-31T10:00:00Z", "2023-03-26T00:30:00Z", nil},
+			{"2023-01-31T10:30:00Z", "2023-03-26T04:00:00Z", "2023-01-01T00:00:00Z"},
+			{"2023-01-31", "2023-03-26", "2023-12-31"},
+			{"30m", "2h", "24h"},
+		})
+	require.NoError(t, err)
+
+	t.Run("SubTimestamps", func(t *testing.T) {
+		res, err := b.SubTimestamps(1, 0, "elapsed")
+		require.NoError(t, err)
+		assert.Equal(t, Duration, res.ColumnType(4))
+		assert.Equal(t, arrow.Duration(30*time.Minute), res.GetValue(4, 0))
+		assert.Equal(t, arrow.Duration(3*time.Hour+30*time.Minute), res.GetValue(4, 1))
+		assert.Nil(t, res.GetValue(4, 2))
+
+		res, err = b.SubTimestamps(2, 2, "zero")
+		require.NoError(t, err)
+		assert.Equal(t, arrow.Duration(0), res.GetValue(4, 0))
+
+		_, err = b.SubTimestamps(0, 3, "invalid")
+		assert.Error(t, err)
+	})
+
+	t.Run("AddDuration", func(t *testing.T) {
+		res, err := b.AddDuration(0, 3)
+		require.NoError(t, err)
+		assert.Equal(t, TimestampSec, res.ColumnType(0))
+		expect, _ := ToTimestamp("2023-01-31T10:30:00Z", arrow.Second)
+		assert.Equal(t, expect, res.GetValue(0, 0))
+		assert.Nil(t, res.GetValue(0, 2))
+
+		res, err = b.AddDuration(2, 3)
+		require.NoError(t, err)
+		assert.Equal(t, "2024-01-01", res.GetValue(2, 2).(arrow.Date32).ToTime().Format(dateLayout))
+
+		_, err = b.AddDuration(0, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("AddDate", func(t *testing.T) {
+		res, err := b.AddDate(2, 0, 1, 0)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-03-03", res.GetValue(2, 0).(arrow.Date32).ToTime().Format(dateLayout))
+
+		zoned, err := b.NewSeriesFromCol(0).WithTimeZone("Europe/Paris")
+		require.NoError(t, err)
+		zonedBow, err := NewBow(zoned)
+		require.NoError(t, err)
+
+		// Daylight saving time starts on 2023-03-26 in Paris, calendar days are kept instead of 24 hours
+		res, err = zonedBow.AddDate(0, 0, 0, 54)
+		require.NoError(t, err)
+		expect, _ := ToTimestamp("2023-03-26T09:00:00Z", arrow.Second)
+		assert.Equal(t, expect, res.GetValue(0, 0))
+		assert.Equal(t, "Europe/Paris", res.Schema().Field(0).Type.(*arrow.TimestampType).TimeZone)
+
+		_, err = b.AddDate(3, 0, 0, 1)
+		assert.Error(t, err)
+	})
+
+	t.Run("String", func(t *testing.T) {
+		res, err := b.Select(2, 3)
+		require.NoError(t, err)
+		assert.Equal(t, "day:date32  outage:duration[ns]\n"+
+			"2023-01-31  30m0s\n"+
+			"2023-03-26  2h0m0s\n"+
+			"2023-12-31  24h0m0s\n", res.String())
+	})
+}
@@ -24,6 +24,48 @@ const (
 	Boolean
 	String
 
+	// TimestampSec and following types are arrow.TimestampType with the corresponding arrow.TimeUnit.
+	// They are stored without time zone by default, see Series.WithTimeZone.
+	TimestampSec
+	TimestampMilli
+	TimestampMicro
+	TimestampNano
+
+	// Int8 and following types are narrower or unsigned numeric arrow types.
+	Int8
+	Int16
+	Int32
+	Uint8
+	Uint16
+	Uint32
+	Uint64
+	Float32
+
+	// Dictionary represents dictionary-encoded strings, suited for categorical data with many repeated values.
+	// Values are stored once in a dictionary and referenced by int32 codes.
+	Dictionary
+
+	// Decimal represents exact fixed-point numbers,
+	// with DecimalPrecision significant digits and DecimalScale digits after the decimal point.
+	Decimal
+
+	// List represents variable-length lists of values of the same Type, as []interface{} values.
+	// The Type of the list elements is inferred from the values.
+	List
+
+	// Struct represents nested records of named fields, as map[string]interface{} values.
+	// The fields and their Type are inferred from the values.
+	Struct
+
+	// Binary represents variable-length byte sequences, as []byte values.
+	Binary
+
+	// Date represents calendar dates without time, as arrow.Date32 values counting the days since the UNIX epoch.
+	Date
+
+	// Duration represents elapsed times, as arrow.Duration values in nanoseconds.
+	Duration
+
 	// InputDependent is used in aggregations when the output type is dependent on the input type.
 	InputDependent
 
@@ -37,11 +79,46 @@ var (
 		Int64:   arrow.PrimitiveTypes.Int64,
 		Boolean: arrow.FixedWidthTypes.Boolean,
 		String:  arrow.BinaryTypes.String,
+
+		TimestampSec:   &arrow.TimestampType{Unit: arrow.Second},
+		TimestampMilli: &arrow.TimestampType{Unit: arrow.Millisecond},
+		TimestampMicro: &arrow.TimestampType{Unit: arrow.Microsecond},
+		TimestampNano:  &arrow.TimestampType{Unit: arrow.Nanosecond},
+
+		Int8:    arrow.PrimitiveTypes.Int8,
+		Int16:   arrow.PrimitiveTypes.Int16,
+		Int32:   arrow.PrimitiveTypes.Int32,
+		Uint8:   arrow.PrimitiveTypes.Uint8,
+		Uint16:  arrow.PrimitiveTypes.Uint16,
+		Uint32:  arrow.PrimitiveTypes.Uint32,
+		Uint64:  arrow.PrimitiveTypes.Uint64,
+		Float32: arrow.PrimitiveTypes.Float32,
+
+		Dictionary: &arrow.DictionaryType{
+			IndexType: arrow.PrimitiveTypes.Int32,
+			ValueType: arrow.BinaryTypes.String,
+		},
+
+		Decimal: &arrow.Decimal128Type{Precision: DecimalPrecision, Scale: DecimalScale},
+
+		List:   arrow.ListOf(arrow.PrimitiveTypes.Float64),
+		Struct: arrow.StructOf(),
+
+		Binary: arrow.BinaryTypes.Binary,
+
+		Date:     arrow.FixedWidthTypes.Date32,
+		Duration: arrow.FixedWidthTypes.Duration_ns,
 	}
-	mapArrowNameToBowTypes = func() map[string]Type {
+	mapArrowTimeUnitToBowTimestampTypes = map[arrow.TimeUnit]Type{
+		arrow.Second:      TimestampSec,
+		arrow.Millisecond: TimestampMilli,
+		arrow.Microsecond: TimestampMicro,
+		arrow.Nanosecond:  TimestampNano,
+	}
+	mapArrowStringToBowTypes = func() map[string]Type {
 		res := make(map[string]Type)
-		for bowType, arrowDataType := range mapBowToArrowTypes {
-			res[arrowDataType.Name()] = bowType
+		for bowType := range mapBowToArrowTypes {
+			res[bowType.String()] = bowType
 		}
 		return res
 	}()
@@ -71,8 +148,38 @@ func (t Type) Convert(input interface{}) interface{} {
 		output, ok = ToInt64(input)
 	case Boolean:
 		output, ok = ToBoolean(input)
-	case String:
+	case String, Dictionary:
 		output, ok = ToString(input)
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		output, ok = ToTimestamp(input, t.TimeUnit())
+	case Int8:
+		output, ok = ToInt8(input)
+	case Int16:
+		output, ok = ToInt16(input)
+	case Int32:
+		output, ok = ToInt32(input)
+	case Uint8:
+		output, ok = ToUint8(input)
+	case Uint16:
+		output, ok = ToUint16(input)
+	case Uint32:
+		output, ok = ToUint32(input)
+	case Uint64:
+		output, ok = ToUint64(input)
+	case Float32:
+		output, ok = ToFloat32(input)
+	case Decimal:
+		output, ok = ToDecimal(input)
+	case List:
+		output, ok = ToList(input)
+	case Struct:
+		output, ok = ToStruct(input)
+	case Binary:
+		output, ok = ToBinary(input)
+	case Date:
+		output, ok = ToDate(input)
+	case Duration:
+		output, ok = ToDuration(input)
 	}
 	if ok {
 		return output
@@ -87,7 +194,14 @@ func (t Type) IsSupported() bool {
 }
 
 // String returns the string representation of the Type t.
+// Nested types are represented regardless of the types of their children.
 func (t Type) String() string {
+	switch t {
+	case List:
+		return "list"
+	case Struct:
+		return "struct"
+	}
 	at, ok := mapBowToArrowTypes[t]
 	if !ok {
 		return "undefined"
@@ -95,6 +209,66 @@ func (t Type) String() string {
 	return fmt.Sprintf("%s", at)
 }
 
+// IsTimestamp returns true if the Type t is one of the timestamp types.
+func (t Type) IsTimestamp() bool {
+	switch t {
+	case TimestampSec, TimestampMilli, TimestampMicro, TimestampNano:
+		return true
+	}
+	return false
+}
+
+// TimeUnit returns the arrow.TimeUnit of a timestamp Type t.
+// Panics if t is not a timestamp type.
+func (t Type) TimeUnit() arrow.TimeUnit {
+	if !t.IsTimestamp() {
+		panic(fmt.Errorf("type '%s' has no time unit", t))
+	}
+	return mapBowToArrowTypes[t].(*arrow.TimestampType).Unit
+}
+
+// getBowTypeFromArrowType returns the Bow Type matching the arrow.DataType.
+// Timestamp types are matched on their unit only, regardless of their time zone.
+// Dictionary types are matched on their string values, regardless of their index type.
+// Decimal128 types are matched regardless of their precision and scale, their values being rescaled by newRecord.
+// List and Struct types are matched regardless of the types of their children, which need to be supported,
+// decimal children being only supported with the precision and scale of the Decimal type.
+func getBowTypeFromArrowType(dataType arrow.DataType) Type {
+	switch dataType := dataType.(type) {
+	case *arrow.TimestampType:
+		return mapArrowTimeUnitToBowTimestampTypes[dataType.Unit]
+	case *arrow.DictionaryType:
+		if dataType.ValueType.ID() == arrow.STRING {
+			return Dictionary
+		}
+		return Unknown
+	case *arrow.Decimal128Type:
+		return Decimal
+	case *arrow.ListType:
+		if getBowTypeFromArrowChildType(dataType.Elem()) == Unknown {
+			return Unknown
+		}
+		return List
+	case *arrow.StructType:
+		for _, field := range dataType.Fields() {
+			if getBowTypeFromArrowChildType(field.Type) == Unknown {
+				return Unknown
+			}
+		}
+		return Struct
+	}
+	return getBowTypeFromArrowFingerprint(dataType.Fingerprint())
+}
+
+// getBowTypeFromArrowChildType returns the Bow Type matching the arrow.DataType of a List or Struct child,
+// which is not rescaled if it is a decimal.
+func getBowTypeFromArrowChildType(dataType arrow.DataType) Type {
+	if _, ok := dataType.(*arrow.Decimal128Type); ok && !arrow.TypeEqual(dataType, mapBowToArrowTypes[Decimal]) {
+		return Unknown
+	}
+	return getBowTypeFromArrowType(dataType)
+}
+
 func getBowTypeFromArrowFingerprint(fingerprint string) Type {
 	typ, ok := mapArrowFingerprintToBowTypes[fingerprint]
 	if !ok {
@@ -103,8 +277,8 @@ func getBowTypeFromArrowFingerprint(fingerprint string) Type {
 	return typ
 }
 
-func getBowTypeFromArrowName(name string) Type {
-	typ, ok := mapArrowNameToBowTypes[name]
+func getBowTypeFromArrowString(str string) Type {
+	typ, ok := mapArrowStringToBowTypes[str]
 	if !ok {
 		return Unknown
 	}
@@ -117,3 +291,46 @@ func GetAllTypes() []Type {
 	copy(res, allType)
 	return res
 }
+
+// isInteger returns true if the Type t is one of the signed or unsigned integer types.
+func (t Type) isInteger() bool {
+	switch t {
+	case Int8, Int16, Int32, Int64, Uint8, Uint16, Uint32, Uint64:
+		return true
+	}
+	return false
+}
+
+// isUnsigned returns true if the Type t is one of the unsigned integer types.
+func (t Type) isUnsigned() bool {
+	switch t {
+	case Uint8, Uint16, Uint32, Uint64:
+		return true
+	}
+	return false
+}
+
+// isNumeric returns true if the Type t is one of the integer or floating point types.
+func (t Type) isNumeric() bool {
+	return t.isInteger() || t == Float32 || t == Float64
+}
+
+// promoteNumericTypes returns the Type of the result of an arithmetic operation between values of Types t1 and t2:
+// Int64 if both are signed integer types, Uint64 if both are unsigned integer types, Float64 otherwise,
+// including a signed with an unsigned integer type, which no integer type can fully represent.
+// Integer operations wrap around on overflow, as Go integer arithmetic.
+// Returns an error if any of them is not a numeric type.
+func promoteNumericTypes(t1, t2 Type) (Type, error) {
+	if !t1.isNumeric() || !t2.isNumeric() {
+		return Unknown, fmt.Errorf("types '%s' and '%s' are not both numeric", t1, t2)
+	}
+
+	switch {
+	case !t1.isInteger() || !t2.isInteger() || t1.isUnsigned() != t2.isUnsigned():
+		return Float64, nil
+	case t1.isUnsigned():
+		return Uint64, nil
+	default:
+		return Int64, nil
+	}
+}
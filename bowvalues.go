@@ -1,6 +1,9 @@
 package bow
 
-import "github.com/apache/arrow/go/v8/arrow/array"
+import (
+	"github.com/apache/arrow/go/v8/arrow"
+	"github.com/apache/arrow/go/v8/arrow/array"
+)
 
 func int64Values(arr *array.Int64) []int64 {
 	return arr.Int64Values()
@@ -25,3 +28,110 @@ func stringValues(arr *array.String) []string {
 	}
 	return res
 }
+
+// binaryValues returns copies of the values, as the array ones share its underlying buffer.
+func binaryValues(arr *array.Binary) [][]byte {
+	var res = make([][]byte, arr.Len())
+	for i := range res {
+		if arr.IsValid(i) {
+			res[i] = append([]byte{}, arr.Value(i)...)
+		}
+	}
+	return res
+}
+
+func timestampValues(arr *array.Timestamp) []arrow.Timestamp {
+	return arr.TimestampValues()
+}
+
+func dictionaryValues(arr *array.Dictionary) []string {
+	dict := arr.Dictionary().(*array.String)
+	var res = make([]string, arr.Len())
+	for i := range res {
+		if arr.IsValid(i) {
+			res[i] = dict.Value(arr.GetValueIndex(i))
+		}
+	}
+	return res
+}
+
+// dictionaryCodes returns the set of dictionary codes whose values match one of `values`.
+func dictionaryCodes(arr *array.Dictionary, values ...interface{}) map[int]struct{} {
+	dict := arr.Dictionary().(*array.String)
+	res := make(map[int]struct{})
+	for _, value := range values {
+		str, ok := ToString(value)
+		if !ok {
+			continue
+		}
+		for code := 0; code < dict.Len(); code++ {
+			if dict.IsValid(code) && dict.Value(code) == str {
+				res[code] = struct{}{}
+			}
+		}
+	}
+	return res
+}
+
+func decimalValues(arr *array.Decimal128) []Decimal128 {
+	var res = make([]Decimal128, arr.Len())
+	for i, v := range arr.Values() {
+		res[i] = Decimal128(v)
+	}
+	return res
+}
+
+func listValues(arr *array.List) [][]interface{} {
+	var res = make([][]interface{}, arr.Len())
+	for i := range res {
+		if arr.IsValid(i) {
+			res[i] = listValue(arr, i)
+		}
+	}
+	return res
+}
+
+// listValue returns the elements of the list at index `i`, with the same Go types as returned by GetValue.
+func listValue(arr *array.List, i int) []interface{} {
+	j := i + arr.Data().Offset()
+	elems := array.NewSlice(arr.ListValues(), int64(arr.Offsets()[j]), int64(arr.Offsets()[j+1]))
+	defer elems.Release()
+
+	b := newBowFromArrays(
+		[]arrow.Field{{Name: "item", Type: elems.DataType(), Nullable: true}},
+		[]arrow.Array{elems}, elems.Len())
+	var res = make([]interface{}, elems.Len())
+	for k := range res {
+		res[k] = b.GetValue(0, k)
+	}
+	return res
+}
+
+func structValues(arr *array.Struct) []map[string]interface{} {
+	b := newBowFromStructFields(arr)
+	var res = make([]map[string]interface{}, arr.Len())
+	for i := range res {
+		if arr.IsValid(i) {
+			res[i] = b.GetRow(i)
+		}
+	}
+	return res
+}
+
+// structValue returns the non-nil fields of the struct at index `i`, with the same Go types as returned by GetValue.
+func structValue(arr *array.Struct, i int) map[string]interface{} {
+	return newBowFromStructFields(arr).GetRow(i)
+}
+
+func newBowFromStructFields(arr *array.Struct) *bow {
+	fields := arr.DataType().(*arrow.StructType).Fields()
+	arrays := make([]arrow.Array, arr.NumField())
+	for i := range arrays {
+		arrays[i] = arr.Field(i)
+	}
+	return newBowFromArrays(fields, arrays, arr.Len())
+}
+
+func newBowFromArrays(fields []arrow.Field, arrays []arrow.Array, numRows int) *bow {
+	return &bow{Record: array.NewRecord(arrow.NewSchema(fields, nil), arrays, int64(numRows))}
+}
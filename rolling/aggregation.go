@@ -109,14 +109,15 @@ func (a *colAggregation) SetTransformations(transformations ...transformation.Fu
 
 func (a *colAggregation) GetReturnType(inputType, iteratorType bow.Type) bow.Type {
 	switch a.Type() {
-	case bow.Int64, bow.Float64, bow.Boolean, bow.String:
-		return a.Type()
 	case bow.InputDependent:
 		return inputType
 	case bow.IteratorDependent:
 		return iteratorType
 	default:
-		panic(fmt.Errorf("invalid return type %v", a.Type()))
+		if !a.Type().IsSupported() {
+			panic(fmt.Errorf("invalid return type %v", a.Type()))
+		}
+		return a.Type()
 	}
 }
 
@@ -85,6 +85,23 @@ var (
 			{61, "test"}, // valid with two values NOT on start of window
 			{69, "20."},
 		})
+	sparseDecimalBow, _ = bow.NewBowFromRowBasedInterfaces(
+		[]string{timeCol, valueCol},
+		[]bow.Type{bow.Int64, bow.Decimal},
+		[][]interface{}{
+			{10, "0.1"}, // partially valid window
+			{11, nil},
+			{20, nil}, // only invalid window
+
+			// empty window
+
+			{40, nil}, // partially valid with start of window invalid
+			{41, "0.1"},
+			{50, "0.1"}, // valid with two values on start of window
+			{51, "0.2"},
+			{61, "12345678901234567890.000000001"}, // valid with two values NOT on start of window
+			{69, "0.2"},
+		})
 )
 
 func runTestCases(t *testing.T, aggrConstruct rolling.ColAggregationConstruct,
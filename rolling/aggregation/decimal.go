@@ -0,0 +1,59 @@
+package aggregation
+
+import (
+	"fmt"
+
+	"github.com/metronlab/bow"
+	"github.com/metronlab/bow/rolling"
+	"github.com/metronlab/bow/rolling/transformation"
+)
+
+// decimalColAggregation is a ColAggregation returning exact Decimal values on Decimal input columns,
+// instead of its default return type.
+type decimalColAggregation struct {
+	rolling.ColAggregation
+}
+
+func (a decimalColAggregation) GetReturnType(inputType, iteratorType bow.Type) bow.Type {
+	if inputType == bow.Decimal {
+		return bow.Decimal
+	}
+	return a.ColAggregation.GetReturnType(inputType, iteratorType)
+}
+
+func (a decimalColAggregation) RenameOutput(name string) rolling.ColAggregation {
+	return decimalColAggregation{a.ColAggregation.RenameOutput(name)}
+}
+
+func (a decimalColAggregation) SetTransformations(transformations ...transformation.Func) rolling.ColAggregation {
+	return decimalColAggregation{a.ColAggregation.SetTransformations(transformations...)}
+}
+
+func sumDecimal(col int, w rolling.Window) (interface{}, error) {
+	var sum bow.Decimal128
+	for i := 0; i < w.Bow.NumRows(); i++ {
+		value, ok := w.Bow.GetValue(col, i).(bow.Decimal128)
+		if !ok {
+			continue
+		}
+		if sum, ok = sum.Add(value); !ok {
+			return nil, fmt.Errorf("decimal sum exceeds %d digits", bow.DecimalPrecision)
+		}
+	}
+	return sum, nil
+}
+
+// extremumDecimal returns the minimum Decimal value of the window if `sign` is -1, or the maximum if `sign` is 1.
+func extremumDecimal(col int, w rolling.Window, sign int) (interface{}, error) {
+	var extremum interface{}
+	for i := 0; i < w.Bow.NumRows(); i++ {
+		value, ok := w.Bow.GetValue(col, i).(bow.Decimal128)
+		if !ok {
+			continue
+		}
+		if extremum == nil || value.Cmp(extremum.(bow.Decimal128)) == sign {
+			extremum = value
+		}
+	}
+	return extremum, nil
+}
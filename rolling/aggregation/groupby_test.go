@@ -0,0 +1,108 @@
+package aggregation
+
+import (
+	"testing"
+
+	"github.com/metronlab/bow"
+	"github.com/metronlab/bow/rolling"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupBy_Aggregate(t *testing.T) {
+	b, err := bow.NewBowFromRowBasedInterfaces(
+		[]string{timeCol, "device", "tag", valueCol},
+		[]bow.Type{bow.Int64, bow.String, bow.Dictionary, bow.Float64},
+		[][]interface{}{
+			{1, "d2", "a", 1.},
+			{2, "d1", "a", 2.},
+			{3, "d2", "b", nil},
+			{4, "d1", "a", 4.},
+			{5, nil, "a", 5.},
+			{6, "d2", "a", 3.},
+			{7, "d1", "a", 2.},
+		})
+	require.NoError(t, err)
+
+	t.Run("one key column", func(t *testing.T) {
+		g, err := rolling.GroupBy(b, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 3, g.NumGroups())
+
+		res, err := g.Aggregate(
+			Sum(valueCol).RenameOutput("sum"),
+			ArithmeticMean(valueCol).RenameOutput("mean"),
+			Min(valueCol).RenameOutput("min"),
+			Max(valueCol).RenameOutput("max"),
+			Count(valueCol).RenameOutput("count"),
+			First(timeCol).RenameOutput("first"),
+			Last(timeCol).RenameOutput("last"),
+			Mode(valueCol).RenameOutput("mode"),
+		)
+		require.NoError(t, err)
+
+		expected, err := bow.NewBowFromRowBasedInterfaces(
+			[]string{"device", "sum", "mean", "min", "max", "count", "first", "last", "mode"},
+			[]bow.Type{bow.String, bow.Float64, bow.Float64, bow.Float64, bow.Float64,
+				bow.Int64, bow.Int64, bow.Int64, bow.Float64},
+			[][]interface{}{
+				{"d1", 8., 8. / 3, 2., 4., 3, 2, 7, 2.},
+				{"d2", 4., 2., 1., 3., 2, 1, 6, 1.},
+				{nil, 5., 5., 5., 5., 1, 5, 5, 5.},
+			})
+		require.NoError(t, err)
+		expectEqual(t, expected, res)
+	})
+
+	t.Run("several key columns", func(t *testing.T) {
+		g, err := rolling.GroupBy(b, 2, 1)
+		require.NoError(t, err)
+
+		res, err := g.Aggregate(Count(valueCol))
+		require.NoError(t, err)
+
+		expected, err := bow.NewBowFromRowBasedInterfaces(
+			[]string{"tag", "device", valueCol},
+			[]bow.Type{bow.Dictionary, bow.String, bow.Int64},
+			[][]interface{}{
+				{"a", "d1", 3},
+				{"a", "d2", 2},
+				{"a", nil, 1},
+				{"b", "d2", 0},
+			})
+		require.NoError(t, err)
+		expectEqual(t, expected, res)
+	})
+
+	t.Run("empty bow", func(t *testing.T) {
+		g, err := rolling.GroupBy(b.NewEmptySlice(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, 0, g.NumGroups())
+
+		res, err := g.Aggregate(Sum(valueCol))
+		require.NoError(t, err)
+
+		expected, err := bow.NewBow(
+			bow.NewSeries("device", bow.String, []string{}, nil),
+			bow.NewSeries(valueCol, bow.Float64, []float64{}, nil),
+		)
+		require.NoError(t, err)
+		expectEqual(t, expected, res)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		g, err := rolling.GroupBy(b, 1)
+		require.NoError(t, err)
+
+		_, err = g.Aggregate()
+		assert.Error(t, err)
+		_, err = g.Aggregate(Sum("unknown"))
+		assert.Error(t, err)
+		_, err = g.Aggregate(WindowStart(timeCol))
+		assert.Error(t, err)
+	})
+}
+
+func expectEqual(t *testing.T, expect, have bow.Bow) {
+	assert.True(t, expect.Equal(have), "expect:\n%shave:\n%s", expect, have)
+}
@@ -6,11 +6,14 @@ import (
 )
 
 func Min(col string) rolling.ColAggregation {
-	return rolling.NewColAggregation(col, false, bow.Float64,
+	return decimalColAggregation{rolling.NewColAggregation(col, false, bow.Float64,
 		func(col int, w rolling.Window) (interface{}, error) {
 			if w.Bow.NumRows() == 0 {
 				return nil, nil
 			}
+			if w.Bow.ColumnType(col) == bow.Decimal {
+				return extremumDecimal(col, w, -1)
+			}
 
 			var min interface{}
 			for i := 0; i < w.Bow.NumRows(); i++ {
@@ -27,15 +30,18 @@ func Min(col string) rolling.ColAggregation {
 				min = value
 			}
 			return min, nil
-		})
+		})}
 }
 
 func Max(col string) rolling.ColAggregation {
-	return rolling.NewColAggregation(col, false, bow.Float64,
+	return decimalColAggregation{rolling.NewColAggregation(col, false, bow.Float64,
 		func(col int, w rolling.Window) (interface{}, error) {
 			if w.Bow.NumRows() == 0 {
 				return nil, nil
 			}
+			if w.Bow.ColumnType(col) == bow.Decimal {
+				return extremumDecimal(col, w, 1)
+			}
 
 			var min interface{}
 			for i := 0; i < w.Bow.NumRows(); i++ {
@@ -52,5 +58,5 @@ func Max(col string) rolling.ColAggregation {
 				min = value
 			}
 			return min, nil
-		})
+		})}
 }
@@ -78,6 +78,25 @@ func TestMin(t *testing.T) {
 				return b
 			}(),
 		},
+		{
+			name:      "sparse decimal",
+			testedBow: sparseDecimalBow,
+			expectedBow: func() bow.Bow {
+				b, err := bow.NewBowFromRowBasedInterfaces(
+					[]string{timeCol, valueCol},
+					[]bow.Type{bow.Int64, bow.Decimal},
+					[][]interface{}{
+						{10, "0.1"},
+						{20, nil},
+						{30, nil},
+						{40, "0.1"},
+						{50, "0.1"},
+						{60, "0.2"},
+					})
+				assert.NoError(t, err)
+				return b
+			}(),
+		},
 	})
 }
 
@@ -152,5 +171,24 @@ func TestMax(t *testing.T) {
 				return b
 			}(),
 		},
+		{
+			name:      "sparse decimal",
+			testedBow: sparseDecimalBow,
+			expectedBow: func() bow.Bow {
+				b, err := bow.NewBowFromRowBasedInterfaces(
+					[]string{timeCol, valueCol},
+					[]bow.Type{bow.Int64, bow.Decimal},
+					[][]interface{}{
+						{10, "0.1"},
+						{20, nil},
+						{30, nil},
+						{40, "0.1"},
+						{50, "0.2"},
+						{60, "12345678901234567890.000000001"},
+					})
+				assert.NoError(t, err)
+				return b
+			}(),
+		},
 	})
 }
@@ -6,8 +6,11 @@ import (
 )
 
 func Sum(col string) rolling.ColAggregation {
-	return rolling.NewColAggregation(col, false, bow.Float64,
+	return decimalColAggregation{rolling.NewColAggregation(col, false, bow.Float64,
 		func(col int, w rolling.Window) (interface{}, error) {
+			if w.Bow.ColumnType(col) == bow.Decimal {
+				return sumDecimal(col, w)
+			}
 			if w.Bow.NumRows() == 0 {
 				return 0., nil
 			}
@@ -21,5 +24,5 @@ func Sum(col string) rolling.ColAggregation {
 				sum += value
 			}
 			return sum, nil
-		})
+		})}
 }
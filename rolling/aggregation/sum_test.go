@@ -78,5 +78,24 @@ func TestSum(t *testing.T) {
 				return b
 			}(),
 		},
+		{
+			name:      "sparse decimal",
+			testedBow: sparseDecimalBow,
+			expectedBow: func() bow.Bow {
+				b, err := bow.NewBowFromRowBasedInterfaces(
+					[]string{timeCol, valueCol},
+					[]bow.Type{bow.Int64, bow.Decimal},
+					[][]interface{}{
+						{10, "0.1"},
+						{20, "0"},
+						{30, "0"},
+						{40, "0.1"},
+						{50, "0.3"},
+						{60, "12345678901234567890.200000001"},
+					})
+				assert.NoError(t, err)
+				return b
+			}(),
+		},
 	})
 }
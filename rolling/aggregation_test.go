@@ -50,6 +50,36 @@ func TestIntervalRolling_Aggregate(t *testing.T) {
 		assert.True(t, aggregated.Equal(expected))
 	})
 
+	t.Run("timestamp interval column", func(t *testing.T) {
+		b, err := bow.NewBowFromColBasedInterfaces(
+			[]string{timeCol, valueCol},
+			[]bow.Type{bow.TimestampMilli, bow.Float64},
+			[][]interface{}{
+				{10, 15, 16, 25, 29},
+				{1.0, 1.5, 1.6, 2.5, 2.9},
+			})
+		require.NoError(t, err)
+		r, err := IntervalRolling(b, timeCol, 10, Options{})
+		require.NoError(t, err)
+
+		timestampAggr := NewColAggregation(timeCol, false, bow.IteratorDependent,
+			func(col int, w Window) (interface{}, error) {
+				return w.FirstValue, nil
+			})
+		aggregated, err := r.
+			Aggregate(timestampAggr, valueAggr).
+			Bow()
+		assert.NoError(t, err)
+		expected, _ := bow.NewBowFromColBasedInterfaces(
+			[]string{timeCol, valueCol},
+			[]bow.Type{bow.TimestampMilli, bow.Float64},
+			[][]interface{}{
+				{10, 20},
+				{3., 2.},
+			})
+		expectEqual(t, expected, aggregated)
+	})
+
 	t.Run("swap columns", func(t *testing.T) {
 		aggregated, err := r.
 			Aggregate(valueAggr, timeAggr).
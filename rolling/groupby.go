@@ -0,0 +1,196 @@
+package rolling
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/metronlab/bow"
+)
+
+// Grouped enables processing a Bow group by group, a group holding the rows sharing the same key column values.
+// Groups are ordered by ascending key values, NaN values then nil values last, and keep the original order of their rows.
+// Use Aggregate() to get a Bow with one row per group.
+// Use Next() to iterate over groups.
+type Grouped interface {
+	// Aggregate returns a new Bow with the key columns followed by one column per ColAggregation,
+	// and one row per distinct combination of key values.
+	Aggregate(...ColAggregation) (bow.Bow, error)
+
+	// NumGroups returns the total number of groups.
+	NumGroups() int
+	// HasNext returns true if the next call to Next() will return a new group Window.
+	HasNext() bool
+	// Next returns the next group Window, along with its index.
+	Next() (groupIndex int, window *Window, err error)
+}
+
+type grouped struct {
+	bow     bow.Bow
+	keyCols []int
+	// groupFirstIndices holds the first row index of each group, followed by the number of rows
+	groupFirstIndices []int
+
+	currGroupIndex int
+}
+
+// GroupBy returns a new Grouped gathering the rows of `b` by the distinct combinations of values of the `keyCols` columns.
+// Nil values are grouped together, and so are NaN values. List and Struct columns cannot be used as keys.
+// Group Windows have no interval column: their IntervalColIndex is -1 and their FirstValue and LastValue are 0,
+// hence ColAggregations based on an interval column, such as Integral, WeightedMean or WindowStart, are not supported.
+func GroupBy(b bow.Bow, keyCols ...int) (Grouped, error) {
+	if b == nil {
+		return nil, errors.New("nil bow")
+	}
+	if len(keyCols) == 0 {
+		return nil, errors.New("at least one key column is required")
+	}
+
+	sortKeys := make([]bow.SortKey, len(keyCols))
+	for i, colIndex := range keyCols {
+		if colIndex < 0 || colIndex >= b.NumCols() {
+			return nil, fmt.Errorf("key column %d: column index %d out of bound", i, colIndex)
+		}
+		if typ := b.ColumnType(colIndex); typ == bow.List || typ == bow.Struct {
+			return nil, fmt.Errorf("key column '%s': unsupported type '%s'", b.ColumnName(colIndex), typ)
+		}
+		sortKeys[i] = bow.SortKey{ColIndex: colIndex}
+	}
+
+	sorted, err := b.SortBy(sortKeys...)
+	if err != nil {
+		return nil, fmt.Errorf("bow.SortBy: %w", err)
+	}
+
+	var groupFirstIndices []int
+	for rowIndex := 0; rowIndex < sorted.NumRows(); rowIndex++ {
+		if rowIndex == 0 || !haveSameKeys(sorted, keyCols, rowIndex-1, rowIndex) {
+			groupFirstIndices = append(groupFirstIndices, rowIndex)
+		}
+	}
+	groupFirstIndices = append(groupFirstIndices, sorted.NumRows())
+
+	return &grouped{
+		bow:               sorted,
+		keyCols:           keyCols,
+		groupFirstIndices: groupFirstIndices,
+	}, nil
+}
+
+func haveSameKeys(b bow.Bow, keyCols []int, i, j int) bool {
+	for _, colIndex := range keyCols {
+		v1, v2 := b.GetValue(colIndex, i), b.GetValue(colIndex, j)
+		if b1, ok := v1.([]byte); ok {
+			b2, ok := v2.([]byte)
+			if !ok || !bytes.Equal(b1, b2) {
+				return false
+			}
+			continue
+		}
+		if v1 != v2 && !(isNaN(v1) && isNaN(v2)) {
+			return false
+		}
+	}
+	return true
+}
+
+func isNaN(value interface{}) bool {
+	switch v := value.(type) {
+	case float64:
+		return math.IsNaN(v)
+	case float32:
+		return math.IsNaN(float64(v))
+	}
+	return false
+}
+
+func (g *grouped) NumGroups() int {
+	return len(g.groupFirstIndices) - 1
+}
+
+func (g *grouped) HasNext() bool {
+	return g.currGroupIndex < g.NumGroups()
+}
+
+func (g *grouped) Next() (groupIndex int, window *Window, err error) {
+	if !g.HasNext() {
+		return -1, nil, fmt.Errorf("no more groups")
+	}
+
+	groupIndex = g.currGroupIndex
+	w := g.window(groupIndex)
+	g.currGroupIndex++
+	return groupIndex, &w, nil
+}
+
+func (g *grouped) window(groupIndex int) Window {
+	firstIndex := g.groupFirstIndices[groupIndex]
+	return Window{
+		Bow:              g.bow.NewSlice(firstIndex, g.groupFirstIndices[groupIndex+1]),
+		FirstIndex:       firstIndex,
+		IntervalColIndex: -1,
+	}
+}
+
+func (g *grouped) Aggregate(aggrs ...ColAggregation) (bow.Bow, error) {
+	if len(aggrs) == 0 {
+		return nil, errors.New("at least one column aggregation is required")
+	}
+
+	series := make([]bow.Series, 0, len(g.keyCols)+len(aggrs))
+	for _, colIndex := range g.keyCols {
+		buf := bow.NewBuffer(g.NumGroups(), g.bow.ColumnType(colIndex))
+		for groupIndex := 0; groupIndex < g.NumGroups(); groupIndex++ {
+			buf.SetOrDropStrict(groupIndex, g.bow.GetValue(colIndex, g.groupFirstIndices[groupIndex]))
+		}
+		series = append(series, bow.NewSeriesFromBuffer(g.bow.ColumnName(colIndex), buf))
+	}
+
+	for aggrIndex, aggr := range aggrs {
+		if aggr.InputName() == "" {
+			return nil, fmt.Errorf("column aggregation %d: no input name", aggrIndex)
+		}
+		if aggr.Type() == bow.IteratorDependent {
+			return nil, fmt.Errorf("column aggregation %d: groups have no interval column", aggrIndex)
+		}
+
+		inputColIndex, err := g.bow.ColumnIndex(aggr.InputName())
+		if err != nil {
+			return nil, fmt.Errorf("column aggregation %d: %w", aggrIndex, err)
+		}
+
+		aggr.SetInputIndex(inputColIndex)
+
+		name := aggr.OutputName()
+		if name == "" {
+			name = g.bow.ColumnName(aggr.InputIndex())
+		}
+
+		typ := aggr.GetReturnType(
+			g.bow.ColumnType(aggr.InputIndex()),
+			g.bow.ColumnType(aggr.InputIndex()))
+		buf := bow.NewBuffer(g.NumGroups(), typ)
+
+		for groupIndex := 0; groupIndex < g.NumGroups(); groupIndex++ {
+			aggrValue, err := aggr.Func()(aggr.InputIndex(), g.window(groupIndex))
+			if err != nil {
+				return nil, fmt.Errorf("column aggregation %d: group %d: %w", aggrIndex, groupIndex, err)
+			}
+
+			for transIndex, trans := range aggr.Transformations() {
+				aggrValue, err = trans(aggrValue)
+				if err != nil {
+					return nil, fmt.Errorf("column aggregation %d: group %d: transIndex %d: %w",
+						aggrIndex, groupIndex, transIndex, err)
+				}
+			}
+
+			buf.SetOrDrop(groupIndex, aggrValue)
+		}
+
+		series = append(series, bow.NewSeriesFromBuffer(name, buf))
+	}
+
+	return bow.NewBowWithMetadata(g.bow.Metadata(), series...)
+}
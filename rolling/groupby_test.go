@@ -0,0 +1,84 @@
+package rolling
+
+import (
+	"math"
+	"testing"
+
+	"github.com/metronlab/bow"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupBy(t *testing.T) {
+	b, err := bow.NewBowFromRowBasedInterfaces(
+		[]string{timeCol, "device", valueCol},
+		[]bow.Type{bow.Int64, bow.Binary, bow.Float64},
+		[][]interface{}{
+			{1, []byte("d2"), 1.},
+			{2, []byte("d1"), 2.},
+			{3, []byte("d2"), 3.},
+			{4, nil, 4.},
+		})
+	require.NoError(t, err)
+
+	t.Run("iterate over groups", func(t *testing.T) {
+		g, err := GroupBy(b, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 3, g.NumGroups())
+
+		var times [][]interface{}
+		for g.HasNext() {
+			groupIndex, w, err := g.Next()
+			require.NoError(t, err)
+			assert.Equal(t, len(times), groupIndex)
+			assert.Equal(t, -1, w.IntervalColIndex)
+
+			var groupTimes []interface{}
+			for i := 0; i < w.Bow.NumRows(); i++ {
+				groupTimes = append(groupTimes, w.Bow.GetValue(0, i))
+			}
+			times = append(times, groupTimes)
+		}
+		assert.Equal(t, [][]interface{}{{int64(2)}, {int64(1), int64(3)}, {int64(4)}}, times)
+
+		_, _, err = g.Next()
+		assert.Error(t, err)
+	})
+
+	t.Run("NaN keys", func(t *testing.T) {
+		nan := math.NaN()
+		b, err := bow.NewBow(
+			bow.NewSeries("f64", bow.Float64, []float64{1, nan, 2, 1, nan, 2, 1}, nil),
+			bow.NewSeries("f32", bow.Float32, []float32{1, float32(nan), 2, 1, float32(nan), 2, 1}, nil),
+		)
+		require.NoError(t, err)
+
+		for _, keyCols := range [][]int{{0}, {1}, {0, 1}} {
+			g, err := GroupBy(b, keyCols...)
+			require.NoError(t, err)
+			assert.Equal(t, 3, g.NumGroups(), "%v", keyCols)
+
+			var groupSizes []int
+			for g.HasNext() {
+				_, w, err := g.Next()
+				require.NoError(t, err)
+				groupSizes = append(groupSizes, w.Bow.NumRows())
+			}
+			assert.Equal(t, []int{3, 2, 2}, groupSizes, "%v", keyCols)
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := GroupBy(nil, 0)
+		assert.Error(t, err)
+		_, err = GroupBy(b)
+		assert.Error(t, err)
+		_, err = GroupBy(b, 3)
+		assert.Error(t, err)
+
+		nested, err := bow.NewBow(bow.NewSeries("list", bow.List, [][]interface{}{{1.}}, nil))
+		require.NoError(t, err)
+		_, err = GroupBy(nested, 0)
+		assert.Error(t, err)
+	})
+}
@@ -6,7 +6,8 @@ import (
 )
 
 func None(colName string) rolling.ColInterpolation {
-	return rolling.NewColInterpolation(colName, []bow.Type{bow.Int64, bow.Float64, bow.Boolean},
+	return rolling.NewColInterpolation(colName, []bow.Type{bow.Int64, bow.Float64, bow.Boolean,
+		bow.TimestampSec, bow.TimestampMilli, bow.TimestampMicro, bow.TimestampNano},
 		func(colIndexToFill int, w rolling.Window, fullBow, prevRow bow.Bow) (interface{}, error) {
 			return nil, nil
 		},
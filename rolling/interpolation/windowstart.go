@@ -6,7 +6,8 @@ import (
 )
 
 func WindowStart(colName string) rolling.ColInterpolation {
-	return rolling.NewColInterpolation(colName, []bow.Type{bow.Int64},
+	return rolling.NewColInterpolation(colName, []bow.Type{bow.Int64,
+		bow.TimestampSec, bow.TimestampMilli, bow.TimestampMicro, bow.TimestampNano},
 		func(colIndexToFill int, w rolling.Window, fullBow, prevRow bow.Bow) (interface{}, error) {
 			return w.FirstValue, nil
 		},
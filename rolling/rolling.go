@@ -54,8 +54,8 @@ type Options struct {
 
 // IntervalRolling returns a new interval-based Rolling with:
 // - b: Bow to process in windows
-// - colName: column on which the interval is based on
-// - interval: numeric value independent of any unit, length of the windows
+// - colName: column on which the interval is based on, of type Int64 or any timestamp Type
+// - interval: numeric value, length of the windows, expressed in the unit of the timestamp column if any
 // All windows except the last one may be empty.
 func IntervalRolling(b bow.Bow, colName string, interval int64, options Options) (Rolling, error) {
 	colIndex, err := b.ColumnIndex(colName)
@@ -67,7 +67,7 @@ func IntervalRolling(b bow.Bow, colName string, interval int64, options Options)
 }
 
 func newIntervalRolling(b bow.Bow, intervalColIndex int, interval int64, options Options) (Rolling, error) {
-	if b.ColumnType(intervalColIndex) != bow.Int64 {
+	if b.ColumnType(intervalColIndex) != bow.Int64 && !b.ColumnType(intervalColIndex).IsTimestamp() {
 		return nil, fmt.Errorf("impossible to create a new intervalRolling on column of type %v",
 			b.ColumnType(intervalColIndex))
 	}
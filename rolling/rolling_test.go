@@ -314,7 +314,11 @@ func checkTestWindow(t *testing.T, r *intervalRolling, expected testWindow) {
 	assert.Equal(t, expected.end, w.LastValue)
 	assert.Equal(t, expected.firstIndex, w.FirstIndex)
 	b := newIntervalRollingTestBow(t, expected.cols)
-	assert.True(t, w.Bow.Equal(b), "expect: %v\nhave: %v", b, w.Bow)
+	expectEqual(t, b, w.Bow)
+}
+
+func expectEqual(t *testing.T, expect, have bow.Bow) {
+	assert.True(t, expect.Equal(have), "expect:\n%shave:\n%s", expect, have)
 }
 
 func newIntervalRollingTestBow(t *testing.T, cols [][]interface{}) bow.Bow {